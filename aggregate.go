@@ -0,0 +1,172 @@
+package ijson
+
+import (
+	"encoding/json"
+	"math/big"
+)
+
+func modCount(data interface{}, _ string) (interface{}, error) {
+	l, err := GetArrayLen(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func modSum(data interface{}, _ string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	s, err := sum(array)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.i != nil {
+		return exactInt(s.i), nil
+	}
+
+	return s.f, nil
+}
+
+func modAvg(data interface{}, _ string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	if len(array) == 0 {
+		return nil, errEmpArr
+	}
+
+	s, err := sum(array)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.i == nil {
+		return s.f / float64(len(array)), nil
+	}
+
+	// the exact sum is divided in big.Float, so the result is rounded only once
+	n := new(big.Float).SetInt64(int64(len(array)))
+	avg, _ := new(big.Float).Quo(new(big.Float).SetInt(s.i), n).Float64()
+
+	return avg, nil
+}
+
+func modMin(data interface{}, _ string) (interface{}, error) {
+	return extreme(data, func(c int) bool { return c < 0 })
+}
+
+func modMax(data interface{}, _ string) (interface{}, error) {
+	return extreme(data, func(c int) bool { return c > 0 })
+}
+
+func modDistinct(data interface{}, _ string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	return distinct(array), nil
+}
+
+// sum returns the sum of all the numbers in array. The sum is an exact integer
+// if the array is not empty and all of its elements are integers, it is a float64 otherwise.
+// An error is returned if any of the elements is not a number.
+func sum(array []interface{}) (num, error) {
+	var (
+		i    = new(big.Int)
+		f    float64
+		ints = len(array) > 0
+	)
+
+	for k := range array {
+		n, ok := parseNum(array[k])
+		if !ok {
+			return num{}, errExpNum
+		}
+
+		if n.i == nil {
+			ints = false
+		} else {
+			i.Add(i, n.i)
+		}
+
+		f += n.float()
+	}
+
+	if ints {
+		return num{i: i}, nil
+	}
+
+	return num{f: f}, nil
+}
+
+// exactInt returns i as int64, OR as json.Number if it does not fit in int64.
+func exactInt(i *big.Int) interface{} {
+	if i.IsInt64() {
+		return i.Int64()
+	}
+
+	return json.Number(i.String())
+}
+
+// extreme returns the element for which better() holds against all other elements.
+// better() receives the result of comparison of an element with the current extreme, see cmpNum().
+// The element is returned as it is, without converting it to float64.
+func extreme(data interface{}, better func(c int) bool) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	if len(array) == 0 {
+		return nil, errEmpArr
+	}
+
+	var res interface{}
+
+	for i := range array {
+		if !isNumber(array[i]) {
+			return nil, errExpNum
+		}
+
+		if i == 0 {
+			res = array[i]
+			continue
+		}
+
+		if c, ok := cmpNum(array[i], res); ok && better(c) {
+			res = array[i]
+		}
+	}
+
+	return res, nil
+}
+
+// distinct returns a new array of distinct values in order of their first occurrence.
+// Values are compared with deep equality, see equal().
+func distinct(array []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(array))
+
+	for i := range array {
+		dup := false
+		for j := range result {
+			if equal(array[i], result[j]) {
+				dup = true
+				break
+			}
+		}
+
+		if !dup {
+			result = append(result, array[i])
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,162 @@
+package ijson
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGet_Aggregate(t *testing.T) {
+	orders := map[string]interface{}{
+		"orders": []interface{}{
+			map[string]interface{}{"id": 1, "total": 10},
+			map[string]interface{}{"id": 2, "total": 2.5},
+			map[string]interface{}{"id": 3, "total": json.Number("7.5")},
+			map[string]interface{}{"id": 1, "total": int64(4)},
+		},
+	}
+
+	type args struct {
+		data interface{}
+		path []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "count",
+			args:    args{data: orders, path: []string{"orders", "@count"}},
+			want:    4,
+			wantErr: false,
+		},
+		{
+			name:    "count/ nil",
+			args:    args{data: nil, path: []string{"@count"}},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name:    "sum/ mixed numeric types",
+			args:    args{data: orders, path: []string{"orders", "#~total", "@sum"}},
+			want:    24.0,
+			wantErr: false,
+		},
+		{
+			name:    "sum/ integers",
+			args:    args{data: []interface{}{json.Number("9007199254740993"), 1, int64(-3)}, path: []string{"@sum"}},
+			want:    int64(9007199254740991),
+			wantErr: false,
+		},
+		{
+			name:    "sum/ integers beyond int64",
+			args:    args{data: []interface{}{int64(9223372036854775807), uint64(1)}, path: []string{"@sum"}},
+			want:    json.Number("9223372036854775808"),
+			wantErr: false,
+		},
+		{
+			name:    "sum/ float64 values",
+			args:    args{data: []interface{}{1.0, 2.0}, path: []string{"@sum"}},
+			want:    3.0,
+			wantErr: false,
+		},
+		{
+			name:    "sum/ empty array",
+			args:    args{data: []interface{}{}, path: []string{"@sum"}},
+			want:    0.0,
+			wantErr: false,
+		},
+		{
+			name:    "sum/ non numeric value",
+			args:    args{data: []interface{}{1, "2"}, path: []string{"@sum"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "sum/ object",
+			args:    args{data: orders, path: []string{"@sum"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "avg",
+			args:    args{data: orders, path: []string{"orders", "#~total", "@avg"}},
+			want:    6.0,
+			wantErr: false,
+		},
+		{
+			name:    "avg/ integers",
+			args:    args{data: []interface{}{json.Number("9007199254740993"), json.Number("9007199254740995")}, path: []string{"@avg"}},
+			want:    float64(9007199254740994),
+			wantErr: false,
+		},
+		{
+			name:    "avg/ integers with integral average",
+			args:    args{data: []interface{}{1, 3}, path: []string{"@avg"}},
+			want:    2.0,
+			wantErr: false,
+		},
+		{
+			name:    "avg/ integers with fraction",
+			args:    args{data: []interface{}{1, 2}, path: []string{"@avg"}},
+			want:    1.5,
+			wantErr: false,
+		},
+		{
+			name:    "avg/ empty array",
+			args:    args{data: []interface{}{}, path: []string{"@avg"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "min/ keeps the original value",
+			args:    args{data: orders, path: []string{"orders", "#~total", "@min"}},
+			want:    2.5,
+			wantErr: false,
+		},
+		{
+			name:    "max/ keeps the original value",
+			args:    args{data: orders, path: []string{"orders", "#~total", "@max"}},
+			want:    10,
+			wantErr: false,
+		},
+		{
+			name:    "max/ empty array",
+			args:    args{data: []interface{}{}, path: []string{"@max"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "min/ non numeric value",
+			args:    args{data: []interface{}{1, nil}, path: []string{"@min"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "distinct",
+			args:    args{data: orders, path: []string{"orders", "#~id", "@distinct"}},
+			want:    []interface{}{1, 2, 3},
+			wantErr: false,
+		},
+		{
+			name:    "distinct/ objects",
+			args:    args{data: Nested(), path: []string{"#0", "friends", "@distinct", "@count"}},
+			want:    2,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(tt.args.data, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
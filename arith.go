@@ -0,0 +1,334 @@
+package ijson
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// Incr adds delta to the number present at the path. A missing OR null value is considered as zero.
+// The existing numeric type is kept when possible, i.e. int stays int if delta is an integer,
+// it becomes float64 otherwise. json.Number stays json.Number.
+// An error is returned if the value at path OR delta is not a number, OR the result overflows an integer type.
+func Incr(data, delta interface{}, path ...string) (interface{}, error) {
+	return arithmetic(data, delta, opAdd, path...)
+}
+
+// IncrP is same as Incr(). It just takes `"."` separated path.
+func IncrP(data, delta interface{}, path string) (interface{}, error) {
+	return Incr(data, delta, split(path)...)
+}
+
+// Mul multiplies the number present at the path by factor. See Incr() for details.
+func Mul(data, factor interface{}, path ...string) (interface{}, error) {
+	return arithmetic(data, factor, opMul, path...)
+}
+
+// MulP is same as Mul(). It just takes `"."` separated path.
+func MulP(data, factor interface{}, path string) (interface{}, error) {
+	return Mul(data, factor, split(path)...)
+}
+
+// Min clamps the number present at the path so that it is not greater than bound.
+// A value within the bound is kept as it is. A missing OR null value is set to bound. See Incr() for details.
+func Min(data, bound interface{}, path ...string) (interface{}, error) {
+	return arithmetic(data, bound, opMin, path...)
+}
+
+// MinP is same as Min(). It just takes `"."` separated path.
+func MinP(data, bound interface{}, path string) (interface{}, error) {
+	return Min(data, bound, split(path)...)
+}
+
+// Max clamps the number present at the path so that it is not less than bound.
+// A value within the bound is kept as it is. A missing OR null value is set to bound. See Incr() for details.
+func Max(data, bound interface{}, path ...string) (interface{}, error) {
+	return arithmetic(data, bound, opMax, path...)
+}
+
+// MaxP is same as Max(). It just takes `"."` separated path.
+func MaxP(data, bound interface{}, path string) (interface{}, error) {
+	return Max(data, bound, split(path)...)
+}
+
+// arithOp is a binary operation on numbers.
+type arithOp struct {
+	ints    func(a, b *big.Int) *big.Int
+	floats  func(a, b float64) float64
+	missing bool // operand is the result for a missing value, otherwise operand is applied to zero
+
+	keep func(c int) bool // reports whether the value is kept as it is, given its comparison with operand
+}
+
+var (
+	opAdd = arithOp{
+		ints:   func(a, b *big.Int) *big.Int { return new(big.Int).Add(a, b) },
+		floats: func(a, b float64) float64 { return a + b },
+	}
+	opMul = arithOp{
+		ints:   func(a, b *big.Int) *big.Int { return new(big.Int).Mul(a, b) },
+		floats: func(a, b float64) float64 { return a * b },
+	}
+	opMin = arithOp{
+		ints: func(a, b *big.Int) *big.Int {
+			if a.Cmp(b) <= 0 {
+				return a
+			}
+
+			return b
+		},
+		floats:  math.Min,
+		missing: true,
+		keep:    func(c int) bool { return c <= 0 },
+	}
+	opMax = arithOp{
+		ints: func(a, b *big.Int) *big.Int {
+			if a.Cmp(b) >= 0 {
+				return a
+			}
+
+			return b
+		},
+		floats:  math.Max,
+		missing: true,
+		keep:    func(c int) bool { return c >= 0 },
+	}
+)
+
+func arithmetic(data, operand interface{}, op arithOp, path ...string) (interface{}, error) {
+	b, ok := parseNum(operand)
+	if !ok {
+		return nil, errExpNum
+	}
+
+	return Update(data, func(old interface{}, exists bool) (interface{}, error) {
+		if !exists || old == nil {
+			if op.missing {
+				return operand, nil
+			}
+
+			old = zero(operand)
+		}
+
+		a, ok := parseNum(old)
+		if !ok {
+			return nil, errExpNum
+		}
+
+		// keep the value with its type, converting it to the type of operand is not required
+		if op.keep != nil {
+			if c, ok := cmpNum(old, operand); ok && op.keep(c) {
+				return old, nil
+			}
+		}
+
+		return fit(apply(op, a, b), old)
+	}, path...)
+}
+
+// num is an exact integer OR a float64.
+type num struct {
+	i *big.Int // nil if the number is not an integer
+	f float64
+}
+
+// parseNum converts any Go numeric value OR json.Number to num.
+func parseNum(v interface{}) (num, bool) {
+	switch n := v.(type) {
+	case int:
+		return num{i: big.NewInt(int64(n))}, true
+	case int8:
+		return num{i: big.NewInt(int64(n))}, true
+	case int16:
+		return num{i: big.NewInt(int64(n))}, true
+	case int32:
+		return num{i: big.NewInt(int64(n))}, true
+	case int64:
+		return num{i: big.NewInt(n)}, true
+	case uint:
+		return num{i: new(big.Int).SetUint64(uint64(n))}, true
+	case uint8:
+		return num{i: new(big.Int).SetUint64(uint64(n))}, true
+	case uint16:
+		return num{i: new(big.Int).SetUint64(uint64(n))}, true
+	case uint32:
+		return num{i: new(big.Int).SetUint64(uint64(n))}, true
+	case uint64:
+		return num{i: new(big.Int).SetUint64(n)}, true
+	case uintptr:
+		return num{i: new(big.Int).SetUint64(uint64(n))}, true
+	case json.Number:
+		if i, ok := new(big.Int).SetString(string(n), 10); ok {
+			return num{i: i}, true
+		}
+	}
+
+	f, ok := toFloat(v)
+
+	return num{f: f}, ok
+}
+
+// apply applies the op on a and b. Integer arithmetic is used if a is an integer
+// and b is an integer OR a float64 with integral value.
+func apply(op arithOp, a, b num) num {
+	if a.i != nil && b.i == nil && b.f == math.Trunc(b.f) && !math.IsInf(b.f, 0) {
+		b.i, _ = big.NewFloat(b.f).Int(nil)
+	}
+
+	if a.i != nil && b.i != nil {
+		return num{i: op.ints(a.i, b.i)}
+	}
+
+	return num{f: op.floats(a.float(), b.float())}
+}
+
+func (n num) float() float64 {
+	if n.i == nil {
+		return n.f
+	}
+
+	f, _ := new(big.Float).SetInt(n.i).Float64()
+
+	return f
+}
+
+// zero returns zero of same type as v, zero of a json.Number is json.Number("0").
+func zero(v interface{}) interface{} {
+	if _, ok := v.(json.Number); ok {
+		return json.Number("0")
+	}
+
+	// zero fits in every numeric type
+	n, _ := fit(num{i: new(big.Int)}, v)
+
+	return n
+}
+
+// fit converts n to the type of like. An error is returned if an integer does not fit in the type.
+// Returns float64 if n is not an integer and like is an integer type.
+func fit(n num, like interface{}) (interface{}, error) {
+	switch like.(type) {
+	case float64:
+		return n.float(), nil
+	case float32:
+		return float32(n.float()), nil
+	case json.Number:
+		if n.i != nil {
+			if !n.i.IsInt64() {
+				return nil, errOvrFlw
+			}
+
+			return json.Number(n.i.String()), nil
+		}
+
+		return json.Number(strconv.FormatFloat(n.f, 'f', -1, 64)), nil
+	}
+
+	if n.i == nil {
+		return n.f, nil
+	}
+
+	if !n.i.IsInt64() {
+		if n.i.Sign() < 0 || !n.i.IsUint64() {
+			return nil, errOvrFlw
+		}
+
+		if v := n.i.Uint64(); isUint(like) && v <= maxUint(like) {
+			return castUint(v, like), nil
+		}
+
+		return nil, errOvrFlw
+	}
+
+	v := n.i.Int64()
+	if isUint(like) {
+		if v < 0 || uint64(v) > maxUint(like) {
+			return nil, errOvrFlw
+		}
+
+		return castUint(uint64(v), like), nil
+	}
+
+	min, max := intRange(like)
+	if v < min || v > max {
+		return nil, errOvrFlw
+	}
+
+	return castInt(v, like), nil
+}
+
+func isUint(v interface{}) bool {
+	switch v.(type) {
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		return true
+	}
+
+	return false
+}
+
+func maxUint(v interface{}) uint64 {
+	switch v.(type) {
+	case uint8:
+		return math.MaxUint8
+	case uint16:
+		return math.MaxUint16
+	case uint32:
+		return math.MaxUint32
+	case uint, uintptr:
+		if strconv.IntSize == 32 {
+			return math.MaxUint32
+		}
+	}
+
+	return math.MaxUint64
+}
+
+func castUint(v uint64, like interface{}) interface{} {
+	switch like.(type) {
+	case uint8:
+		return uint8(v)
+	case uint16:
+		return uint16(v)
+	case uint32:
+		return uint32(v)
+	case uint:
+		return uint(v)
+	case uintptr:
+		return uintptr(v)
+	}
+
+	return v
+}
+
+func intRange(v interface{}) (int64, int64) {
+	switch v.(type) {
+	case int8:
+		return math.MinInt8, math.MaxInt8
+	case int16:
+		return math.MinInt16, math.MaxInt16
+	case int32:
+		return math.MinInt32, math.MaxInt32
+	case int:
+		if strconv.IntSize == 32 {
+			return math.MinInt32, math.MaxInt32
+		}
+	}
+
+	return math.MinInt64, math.MaxInt64
+}
+
+func castInt(v int64, like interface{}) interface{} {
+	switch like.(type) {
+	case int8:
+		return int8(v)
+	case int16:
+		return int16(v)
+	case int32:
+		return int32(v)
+	case int64:
+		return v
+	}
+
+	return int(v)
+}
@@ -0,0 +1,247 @@
+package ijson
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestIncr(t *testing.T) {
+	type args struct {
+		data  interface{}
+		delta interface{}
+		path  []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "int/ int delta",
+			args:    args{data: map[string]interface{}{"views": 1}, delta: 2, path: []string{"views"}},
+			want:    map[string]interface{}{"views": 3},
+			wantErr: false,
+		},
+		{
+			name:    "int/ integral float delta",
+			args:    args{data: map[string]interface{}{"views": 1}, delta: 2.0, path: []string{"views"}},
+			want:    map[string]interface{}{"views": 3},
+			wantErr: false,
+		},
+		{
+			name:    "int/ fractional float delta",
+			args:    args{data: map[string]interface{}{"views": 1}, delta: 0.5, path: []string{"views"}},
+			want:    map[string]interface{}{"views": 1.5},
+			wantErr: false,
+		},
+		{
+			name:    "float/ int delta",
+			args:    args{data: map[string]interface{}{"views": 1.0}, delta: 1, path: []string{"views"}},
+			want:    map[string]interface{}{"views": 2.0},
+			wantErr: false,
+		},
+		{
+			name:    "int8/ keeps type",
+			args:    args{data: []interface{}{int8(1)}, delta: int64(-2), path: []string{"#0"}},
+			want:    []interface{}{int8(-1)},
+			wantErr: false,
+		},
+		{
+			name:    "json number/ integer",
+			args:    args{data: []interface{}{json.Number("9007199254740993")}, delta: 1, path: []string{"#0"}},
+			want:    []interface{}{json.Number("9007199254740994")},
+			wantErr: false,
+		},
+		{
+			name:    "json number/ float",
+			args:    args{data: []interface{}{json.Number("1.5")}, delta: 1, path: []string{"#0"}},
+			want:    []interface{}{json.Number("2.5")},
+			wantErr: false,
+		},
+		{
+			name:    "missing/ created as zero",
+			args:    args{data: nil, delta: 1.0, path: []string{"stats", "views"}},
+			want:    map[string]interface{}{"stats": map[string]interface{}{"views": 1.0}},
+			wantErr: false,
+		},
+		{
+			name:    "null/ considered as zero",
+			args:    args{data: []interface{}{nil}, delta: uint16(1), path: []string{"#0"}},
+			want:    []interface{}{uint16(1)},
+			wantErr: false,
+		},
+		{
+			name:    "int8/ overflow",
+			args:    args{data: []interface{}{int8(127)}, delta: 1, path: []string{"#0"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "int64/ overflow",
+			args:    args{data: []interface{}{int64(math.MaxInt64)}, delta: 1, path: []string{"#0"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "uint/ below zero",
+			args:    args{data: []interface{}{uint(0)}, delta: -1, path: []string{"#0"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "non numeric value",
+			args:    args{data: Object(), delta: 1, path: []string{"name"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "non numeric delta",
+			args:    args{data: Object(), delta: "1", path: []string{"id"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Incr(tt.args.data, tt.args.delta, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Incr() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Incr() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	type args struct {
+		data   interface{}
+		factor interface{}
+		path   []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "int",
+			args:    args{data: []interface{}{3}, factor: 2, path: []string{"#0"}},
+			want:    []interface{}{6},
+			wantErr: false,
+		},
+		{
+			name:    "missing",
+			args:    args{data: []interface{}{}, factor: 2, path: []string{"#0"}},
+			want:    []interface{}{0},
+			wantErr: false,
+		},
+		{
+			name:    "int32/ overflow",
+			args:    args{data: []interface{}{int32(math.MaxInt32)}, factor: 2, path: []string{"#0"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Mul(tt.args.data, tt.args.factor, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Mul() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Mul() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func(data, bound interface{}, path ...string) (interface{}, error)
+		data    interface{}
+		bound   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:  "min/ clamped",
+			fn:    Min,
+			data:  []interface{}{10},
+			bound: 5,
+			want:  []interface{}{5},
+		},
+		{
+			name:  "min/ within bound",
+			fn:    Min,
+			data:  []interface{}{3.5},
+			bound: 5,
+			want:  []interface{}{3.5},
+		},
+		{
+			name:  "min/ within non integral bound",
+			fn:    Min,
+			data:  []interface{}{3},
+			bound: 5.5,
+			want:  []interface{}{3},
+		},
+		{
+			name:  "max/ within bound keeps json.Number",
+			fn:    Max,
+			data:  []interface{}{json.Number("9007199254740993")},
+			bound: 1.5,
+			want:  []interface{}{json.Number("9007199254740993")},
+		},
+		{
+			name:  "max/ clamped",
+			fn:    Max,
+			data:  []interface{}{uint8(1)},
+			bound: 5.0,
+			want:  []interface{}{uint8(5)},
+		},
+		{
+			name:  "max/ missing",
+			fn:    Max,
+			data:  []interface{}{},
+			bound: 5.0,
+			want:  []interface{}{5.0},
+		},
+		{
+			name:    "max/ non numeric",
+			fn:      Max,
+			data:    []interface{}{true},
+			bound:   5,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.data, tt.bound, "#0")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Incr(t *testing.T) {
+	got := New(nil).IncrP(1, "stats.views").IncrP(1, "stats.views").MulP(10, "stats.views").MinP(15, "stats.views").GetP("stats.views")
+
+	want := Result{val: 15}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Incr() = %v, want %v", got, want)
+	}
+}
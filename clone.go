@@ -0,0 +1,254 @@
+package ijson
+
+import "reflect"
+
+// ClonePolicy decides how the values of unknown Go types are cloned.
+// Known types are map[string]interface{}, []interface{}, nil, bool, string, Go numeric types and json.Number.
+type ClonePolicy uint
+
+const (
+	Clone_Reference ClonePolicy = iota // Share the value by reference
+	Clone_Reflect                      // Deep copy the value using reflection
+	Clone_Error                        // Return an error
+)
+
+// Clone returns a deep copy of the data. Scalar values are preserved as they are.
+// Values of unknown Go types are shared by reference. A cyclic reference is not followed,
+// it is shared by reference as well. Use CloneWith() to get an error for such values.
+func Clone(data interface{}) interface{} {
+	c := cloner{policy: Clone_Reference, visiting: make(map[ref]bool), lenient: true}
+	v, _ := c.clone(data)
+
+	return v
+}
+
+// CloneWith returns a deep copy of the data, values of unknown Go types are handled as per the policy.
+// An error is returned if the data contains a cyclic reference OR a value of unknown type with Clone_Error policy.
+func CloneWith(data interface{}, policy ClonePolicy) (interface{}, error) {
+	c := cloner{policy: policy, visiting: make(map[ref]bool)}
+
+	return c.clone(data)
+}
+
+type cloner struct {
+	policy   ClonePolicy
+	visiting map[ref]bool // containers on the current path, to detect cycles
+	lenient  bool         // share the cyclic references instead of returning an error
+}
+
+// ref identifies a container. Slices sharing the backing array differ by length and capacity,
+// i.e. a[:1] within a is not a cycle. Pointers to a struct and its first field differ by type.
+type ref struct {
+	p    uintptr
+	n, c int
+	t    reflect.Type
+}
+
+func refOf(v reflect.Value) ref {
+	r := ref{p: v.Pointer(), t: v.Type()}
+	if v.Kind() == reflect.Slice {
+		r.n, r.c = v.Len(), v.Cap()
+	}
+
+	return r
+}
+
+func (c *cloner) clone(data interface{}) (interface{}, error) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		if v == nil {
+			return v, nil
+		}
+
+		p := refOf(reflect.ValueOf(v))
+		if c.visiting[p] {
+			return c.cycle(data)
+		}
+
+		c.visiting[p] = true
+		defer delete(c.visiting, p)
+
+		object := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			ce, err := c.clone(e)
+			if err != nil {
+				return nil, err
+			}
+
+			object[k] = ce
+		}
+
+		return object, nil
+
+	case []interface{}:
+		if v == nil {
+			return v, nil
+		}
+
+		if len(v) == 0 {
+			return []interface{}{}, nil
+		}
+
+		p := refOf(reflect.ValueOf(v))
+		if c.visiting[p] {
+			return c.cycle(data)
+		}
+
+		c.visiting[p] = true
+		defer delete(c.visiting, p)
+
+		array := make([]interface{}, len(v))
+		for i := range v {
+			ce, err := c.clone(v[i])
+			if err != nil {
+				return nil, err
+			}
+
+			array[i] = ce
+		}
+
+		return array, nil
+
+	case nil, bool, string:
+		return data, nil
+	}
+
+	if isNumber(data) {
+		return data, nil
+	}
+
+	switch c.policy {
+	case Clone_Reflect:
+		v, err := c.reflectClone(reflect.ValueOf(data))
+		if err != nil {
+			return nil, err
+		}
+
+		return v.Interface(), nil
+
+	case Clone_Error:
+		return nil, errUknTyp
+
+	default:
+		return data, nil
+	}
+}
+
+func (c *cloner) cycle(data interface{}) (interface{}, error) {
+	if c.lenient {
+		return data, nil
+	}
+
+	return nil, errCycle
+}
+
+// reflectClone deep copies the value using reflection. Unexported struct fields are copied shallow.
+func (c *cloner) reflectClone(v reflect.Value) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return v, nil
+		}
+
+		if v.Kind() == reflect.Slice && v.Len() == 0 {
+			return reflect.MakeSlice(v.Type(), 0, 0), nil
+		}
+
+		p := refOf(v)
+		if c.visiting[p] {
+			if c.lenient {
+				return v, nil
+			}
+
+			return reflect.Value{}, errCycle
+		}
+
+		c.visiting[p] = true
+		defer delete(c.visiting, p)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		e, err := c.reflectClone(v.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		n := reflect.New(v.Type().Elem())
+		n.Elem().Set(e)
+
+		return n, nil
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, nil
+		}
+
+		e, err := c.clone(v.Elem().Interface())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		n := reflect.New(v.Type()).Elem()
+		if e != nil {
+			n.Set(reflect.ValueOf(e))
+		}
+
+		return n, nil
+
+	case reflect.Map:
+		n := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			e, err := c.reflectClone(iter.Value())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			n.SetMapIndex(iter.Key(), e)
+		}
+
+		return n, nil
+
+	case reflect.Slice, reflect.Array:
+		var n reflect.Value
+		if v.Kind() == reflect.Slice {
+			n = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		} else {
+			n = reflect.New(v.Type()).Elem()
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			e, err := c.reflectClone(v.Index(i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			n.Index(i).Set(e)
+		}
+
+		return n, nil
+
+	case reflect.Struct:
+		n := reflect.New(v.Type()).Elem()
+		n.Set(v)
+
+		for i := 0; i < v.NumField(); i++ {
+			if !n.Field(i).CanSet() {
+				continue
+			}
+
+			e, err := c.reflectClone(v.Field(i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			n.Field(i).Set(e)
+		}
+
+		return n, nil
+
+	default:
+		return v, nil
+	}
+}
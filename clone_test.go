@@ -0,0 +1,172 @@
+package ijson
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type cloneStruct struct {
+	Name string
+	Tags []string
+}
+
+func TestClone(t *testing.T) {
+	data := map[string]interface{}{
+		"id":      int64(1),
+		"count":   uint8(2),
+		"balance": json.Number("2857.19"),
+		"friends": Array(),
+		"tags":    []string{"a", "b"},
+		"empty":   []interface{}{},
+	}
+
+	got := Clone(data)
+	if !reflect.DeepEqual(got, data) {
+		t.Fatalf("Clone() = %v, want %v", got, data)
+	}
+
+	c := got.(map[string]interface{})
+	c["friends"].([]interface{})[0].(map[string]interface{})["name"] = "tom"
+	c["empty"] = append(c["empty"].([]interface{}), 1)
+
+	if !reflect.DeepEqual(data["friends"], Array()) {
+		t.Errorf("Clone() shares the nested data, got %v", data["friends"])
+	}
+
+	// unknown types are shared by reference
+	c["tags"].([]string)[0] = "c"
+	if data["tags"].([]string)[0] != "c" {
+		t.Errorf("Clone() copied the value of unknown type")
+	}
+}
+
+func TestClone_cycle(t *testing.T) {
+	object := map[string]interface{}{"id": 1}
+	object["self"] = object
+
+	got := Clone(object).(map[string]interface{})
+	if reflect.ValueOf(got).Pointer() == reflect.ValueOf(object).Pointer() {
+		t.Errorf("Clone() did not copy the object")
+	}
+
+	if reflect.ValueOf(got["self"]).Pointer() != reflect.ValueOf(object).Pointer() {
+		t.Errorf("Clone() did not share the cyclic reference")
+	}
+
+	if _, err := CloneWith(object, Clone_Reference); err == nil {
+		t.Errorf("CloneWith() error = nil, want error for cyclic reference")
+	}
+}
+
+func TestClone_subslice(t *testing.T) {
+	array := []interface{}{1, nil}
+	array[1] = array[:1]
+
+	got, err := CloneWith(array, Clone_Reference)
+	if err != nil {
+		t.Fatalf("CloneWith() error = %v", err)
+	}
+
+	want := []interface{}{1, []interface{}{1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CloneWith() = %v, want %v", got, want)
+	}
+
+	cyclic := []interface{}{1, nil}
+	cyclic[1] = cyclic
+	if _, err := CloneWith(cyclic, Clone_Reference); err == nil {
+		t.Errorf("CloneWith() error = nil, want error for cyclic reference")
+	}
+}
+
+func TestCloneWith(t *testing.T) {
+	type args struct {
+		data   interface{}
+		policy ClonePolicy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "reference",
+			args:    args{data: []interface{}{[]string{"a"}}, policy: Clone_Reference},
+			want:    []interface{}{[]string{"a"}},
+			wantErr: false,
+		},
+		{
+			name:    "reflect",
+			args:    args{data: []interface{}{&cloneStruct{Name: "tom", Tags: []string{"a"}}}, policy: Clone_Reflect},
+			want:    []interface{}{&cloneStruct{Name: "tom", Tags: []string{"a"}}},
+			wantErr: false,
+		},
+		{
+			name:    "reflect/ nested interface data",
+			args:    args{data: map[string][]interface{}{"a": {Object()}}, policy: Clone_Reflect},
+			want:    map[string][]interface{}{"a": {Object()}},
+			wantErr: false,
+		},
+		{
+			name:    "error",
+			args:    args{data: []interface{}{[]string{"a"}}, policy: Clone_Error},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "error/ known types",
+			args:    args{data: Nested(), policy: Clone_Error},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "error/ known types only",
+			args:    args{data: Array(), policy: Clone_Error},
+			want:    Array(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CloneWith(tt.args.data, tt.args.policy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CloneWith() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CloneWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloneWith_reflect(t *testing.T) {
+	s := &cloneStruct{Name: "tom", Tags: []string{"a"}}
+
+	got, err := CloneWith(s, Clone_Reflect)
+	if err != nil {
+		t.Fatalf("CloneWith() error = %v", err)
+	}
+
+	c := got.(*cloneStruct)
+	c.Tags[0] = "b"
+	if s.Tags[0] != "a" {
+		t.Errorf("CloneWith() shares the nested data, got %v", s.Tags)
+	}
+}
+
+func TestResult_Clone(t *testing.T) {
+	data := Object()
+
+	got := New(data).Clone().Set("tom", "name")
+	if !reflect.DeepEqual(data, Object()) {
+		t.Errorf("Result.Clone() shares the data, got %v", data)
+	}
+
+	want := Result{val: map[string]interface{}{"id": 0, "name": "tom"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Clone() = %v, want %v", got, want)
+	}
+}
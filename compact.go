@@ -0,0 +1,72 @@
+package ijson
+
+// CompactOptions decides which values Compact() drops. The zero value drops nothing.
+type CompactOptions struct {
+	Nulls        bool // drop null values
+	EmptyObjects bool // drop empty objects
+	EmptyArrays  bool // drop empty arrays
+	EmptyStrings bool // drop empty strings
+	Zeros        bool // drop zero numbers and false
+	MaxDepth     int  // compact the objects and arrays up to this depth, the data itself is at depth 1. No limit if zero
+}
+
+// Compact recursively drops the values as per opts from objects and arrays. The data is modified in place.
+// Pruning cascades, i.e. an object that becomes empty after dropping its fields is dropped as well
+// with EmptyObjects option. Order of the array elements is preserved.
+func Compact(data interface{}, opts CompactOptions) interface{} {
+	return compact(data, opts, 1)
+}
+
+func compact(data interface{}, o CompactOptions, depth int) interface{} {
+	if o.MaxDepth > 0 && depth > o.MaxDepth {
+		return data
+	}
+
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			e = compact(e, o, depth+1)
+			if o.drop(e) {
+				delete(v, k)
+				continue
+			}
+
+			v[k] = e
+		}
+
+		return v
+
+	case []interface{}:
+		for i := range v {
+			v[i] = compact(v[i], o, depth+1)
+		}
+
+		v, _ = filter(v, o.drop)
+
+		return v
+	}
+
+	return data
+}
+
+// drop reports whether the value should be dropped.
+func (o CompactOptions) drop(v interface{}) bool {
+	switch e := v.(type) {
+	case nil:
+		return o.Nulls
+	case map[string]interface{}:
+		return o.EmptyObjects && len(e) == 0
+	case []interface{}:
+		return o.EmptyArrays && len(e) == 0
+	case string:
+		return o.EmptyStrings && e == ""
+	case bool:
+		return o.Zeros && !e
+	}
+
+	if f, ok := toFloat(v); ok {
+		return o.Zeros && f == 0
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func sparse() map[string]interface{} {
+	return map[string]interface{}{
+		"name":  "tom",
+		"email": "",
+		"age":   0,
+		"admin": false,
+		"bio":   nil,
+		"tags":  []interface{}{nil, "a", nil, []interface{}{}},
+		"address": map[string]interface{}{
+			"city": nil,
+			"geo":  map[string]interface{}{"lat": nil},
+		},
+	}
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		name string
+		opts CompactOptions
+		want interface{}
+	}{
+		{
+			name: "nothing",
+			opts: CompactOptions{},
+			want: sparse(),
+		},
+		{
+			name: "nulls and empty containers/ cascade",
+			opts: CompactOptions{Nulls: true, EmptyObjects: true, EmptyArrays: true},
+			want: map[string]interface{}{
+				"name":  "tom",
+				"email": "",
+				"age":   0,
+				"admin": false,
+				"tags":  []interface{}{"a"},
+			},
+		},
+		{
+			name: "nulls only",
+			opts: CompactOptions{Nulls: true},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"email":   "",
+				"age":     0,
+				"admin":   false,
+				"tags":    []interface{}{"a", []interface{}{}},
+				"address": map[string]interface{}{"geo": map[string]interface{}{}},
+			},
+		},
+		{
+			name: "empty strings and zeros",
+			opts: CompactOptions{EmptyStrings: true, Zeros: true},
+			want: map[string]interface{}{
+				"name": "tom",
+				"bio":  nil,
+				"tags": []interface{}{nil, "a", nil, []interface{}{}},
+				"address": map[string]interface{}{
+					"city": nil,
+					"geo":  map[string]interface{}{"lat": nil},
+				},
+			},
+		},
+		{
+			name: "max depth",
+			opts: CompactOptions{Nulls: true, EmptyObjects: true, MaxDepth: 2},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"email":   "",
+				"age":     0,
+				"admin":   false,
+				"tags":    []interface{}{"a", []interface{}{}},
+				"address": map[string]interface{}{"geo": map[string]interface{}{"lat": nil}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compact(sparse(), tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Compact(t *testing.T) {
+	got := New([]interface{}{nil, map[string]interface{}{"a": nil}}).Compact(CompactOptions{Nulls: true, EmptyObjects: true})
+
+	want := Result{val: []interface{}{}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Compact() = %v, want %v", got, want)
+	}
+}
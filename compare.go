@@ -0,0 +1,264 @@
+package ijson
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// equal reports whether a and b are deeply equal.
+// Numbers are compared by value irrespective of their type,
+// i.e. int(1), float64(1) and json.Number("1") are equal. Integers are compared exactly,
+// i.e. int64(9007199254740993) and json.Number("9007199254740992") are not equal.
+func equal(a, b interface{}) bool {
+	if isNumber(a) {
+		c, ok := cmpNum(a, b)
+		return ok && c == 0
+	}
+
+	switch x := a.(type) {
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+
+		for k, v := range x {
+			w, exists := y[k]
+			if !exists || !equal(v, w) {
+				return false
+			}
+		}
+
+		return true
+
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// rank returns the position of value's type in the sort order,
+// null < bool < number < string < everything else.
+func rank(v interface{}) int {
+	if v == nil {
+		return 0
+	}
+
+	if _, ok := v.(bool); ok {
+		return 1
+	}
+
+	if isNumber(v) {
+		return 2
+	}
+
+	if _, ok := v.(string); ok {
+		return 3
+	}
+
+	return 4
+}
+
+// compare returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+// Values of different types are ordered as null < bool < number < string < everything else.
+// Objects and arrays are considered equal to each other.
+func compare(a, b interface{}) int {
+	ra, rb := rank(a), rank(b)
+	if ra != rb {
+		if ra < rb {
+			return -1
+		}
+
+		return 1
+	}
+
+	switch ra {
+	case 1:
+		x, y := a.(bool), b.(bool)
+		if x == y {
+			return 0
+		}
+
+		if !x {
+			return -1
+		}
+
+		return 1
+
+	case 2:
+		c, _ := cmpNum(a, b)
+		return c
+
+	case 3:
+		x, y := a.(string), b.(string)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// valueSet is a set of values compared with equal(). Values are looked up by their hash,
+// the values that can not be hashed are compared one by one.
+type valueSet struct {
+	hashed map[string]struct{}
+	others []interface{}
+}
+
+func newValueSet(size int) *valueSet {
+	return &valueSet{hashed: make(map[string]struct{}, size)}
+}
+
+// add adds the value to the set. It returns false if an equal value is already present.
+func (s *valueSet) add(v interface{}) bool {
+	h, ok := hash(v)
+	if !ok {
+		if s.contains(v) {
+			return false
+		}
+
+		s.others = append(s.others, v)
+		return true
+	}
+
+	if _, exists := s.hashed[h]; exists {
+		return false
+	}
+
+	s.hashed[h] = struct{}{}
+	return true
+}
+
+// has reports whether a value equal to v is present in the set.
+func (s *valueSet) has(v interface{}) bool {
+	h, ok := hash(v)
+	if !ok {
+		return s.contains(v)
+	}
+
+	_, exists := s.hashed[h]
+	return exists
+}
+
+// contains compares v with the values that can not be hashed. A value that can be hashed
+// is never equal to one that can not, so the hashed values need not be compared.
+func (s *valueSet) contains(v interface{}) bool {
+	for i := range s.others {
+		if equal(s.others[i], v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hash returns a string representation of the value such that values are equal() if and only if
+// their hashes are same. ok is false if the value can not be hashed, i.e. NaN OR types other than
+// null, bool, number, string, object and array, including the objects and arrays containing them.
+func hash(v interface{}) (h string, ok bool) {
+	var b strings.Builder
+	if !hashTo(&b, v) {
+		return "", false
+	}
+
+	return b.String(), true
+}
+
+func hashTo(b *strings.Builder, v interface{}) bool {
+	switch x := v.(type) {
+	case nil:
+		b.WriteByte('n')
+		return true
+
+	case bool:
+		if x {
+			b.WriteByte('t')
+		} else {
+			b.WriteByte('f')
+		}
+
+		return true
+
+	case string:
+		hashString(b, x)
+		return true
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		b.WriteByte('o')
+		b.WriteString(strconv.Itoa(len(keys)))
+		for _, k := range keys {
+			hashString(b, k)
+			if !hashTo(b, x[k]) {
+				return false
+			}
+		}
+
+		return true
+
+	case []interface{}:
+		b.WriteByte('a')
+		b.WriteString(strconv.Itoa(len(x)))
+		for i := range x {
+			if !hashTo(b, x[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	n, ok := parseNum(v)
+	if !ok || math.IsNaN(n.f) {
+		return false
+	}
+
+	// integers and floats with integral value are written exactly, so that they are same only if cmpNum() says so
+	b.WriteByte('d')
+	switch {
+	case n.i != nil:
+		b.WriteString(n.i.String())
+	case math.IsInf(n.f, 0) || n.f != math.Trunc(n.f):
+		b.WriteString(strconv.FormatFloat(n.f, 'g', -1, 64))
+	default:
+		i, _ := big.NewFloat(n.f).Int(nil)
+		b.WriteString(i.String())
+	}
+
+	b.WriteByte(';')
+	return true
+}
+
+// hashString writes the length prefixed string, so that it can not be confused with the following values.
+func hashString(b *strings.Builder, s string) {
+	b.WriteByte('s')
+	b.WriteString(strconv.Itoa(len(s)))
+	b.WriteByte(':')
+	b.WriteString(s)
+}
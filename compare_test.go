@@ -0,0 +1,179 @@
+package ijson
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func Test_equal(t *testing.T) {
+	type args struct {
+		a interface{}
+		b interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "numbers/ different types",
+			args: args{a: 1, b: json.Number("1")},
+			want: true,
+		},
+		{
+			name: "numbers/ different values",
+			args: args{a: 1, b: 1.5},
+			want: false,
+		},
+		{
+			name: "numbers/ large integers",
+			args: args{a: int64(9007199254740993), b: json.Number("9007199254740993")},
+			want: true,
+		},
+		{
+			name: "numbers/ large integers beyond float64 precision",
+			args: args{a: int64(9007199254740993), b: json.Number("9007199254740992")},
+			want: false,
+		},
+		{
+			name: "numbers/ large integer and float64",
+			args: args{a: int64(9007199254740993), b: float64(9007199254740992)},
+			want: false,
+		},
+		{
+			name: "numbers/ integer and decimal json.Number",
+			args: args{a: int64(2), b: json.Number("2.0")},
+			want: true,
+		},
+		{
+			name: "number and string",
+			args: args{a: 1, b: "1"},
+			want: false,
+		},
+		{
+			name: "objects/ nested numbers",
+			args: args{a: Object(), b: map[string]interface{}{"id": 0.0, "name": "Justine Bird"}},
+			want: true,
+		},
+		{
+			name: "objects/ missing field",
+			args: args{a: Object(), b: map[string]interface{}{"id": 0, "age": 23}},
+			want: false,
+		},
+		{
+			name: "arrays/ same elements",
+			args: args{a: Array(), b: Array()},
+			want: true,
+		},
+		{
+			name: "arrays/ different length",
+			args: args{a: Array(), b: Array()[1:]},
+			want: false,
+		},
+		{
+			name: "nil values",
+			args: args{a: nil, b: nil},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := equal(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_hash(t *testing.T) {
+	values := []interface{}{
+		nil, true, false, 0, -0.0, 1, 1.0, 1.5, int8(1), uint64(1), json.Number("1"), json.Number("1.0"), json.Number("1e0"),
+		int64(9007199254740993), json.Number("9007199254740992"), float64(9007199254740992),
+		1e300, json.Number("1" + strings.Repeat("0", 300)), math.Inf(1), math.Inf(-1), math.NaN(),
+		"", "1", "n", "a", "ab", "s1:a",
+		[]interface{}{}, []interface{}{"a", "b"}, []interface{}{"ab"}, []interface{}{1, "a"}, []interface{}{1.0, "a"},
+		[]interface{}{math.NaN()}, []string{"a"},
+		map[string]interface{}{}, map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1.0},
+		map[string]interface{}{"a": "b"}, map[string]interface{}{"ab": nil}, map[string]interface{}{"a": nil, "b": nil},
+	}
+
+	for i, a := range values {
+		for j, b := range values {
+			ha, okA := hash(a)
+			hb, okB := hash(b)
+
+			want := equal(a, b)
+			if okA && okB && (ha == hb) != want {
+				t.Errorf("hash(%#v) = %q, hash(%#v) = %q, want equal %v", a, ha, b, hb, want)
+			}
+
+			if okA != okB && want {
+				t.Errorf("values %d and %d are equal, but only one of them can be hashed", i, j)
+			}
+		}
+	}
+}
+
+func Test_valueSet(t *testing.T) {
+	s := newValueSet(0)
+
+	for _, v := range []interface{}{1, "1", []string{"a"}, math.NaN()} {
+		if !s.add(v) {
+			t.Errorf("add(%#v) = false, want true", v)
+		}
+	}
+
+	for _, v := range []interface{}{1.0, json.Number("1"), "1", []string{"a"}} {
+		if !s.has(v) || s.add(v) {
+			t.Errorf("value %#v must be present", v)
+		}
+	}
+
+	for _, v := range []interface{}{"2", nil, math.NaN(), []string{"b"}} {
+		if s.has(v) {
+			t.Errorf("has(%#v) = true, want false", v)
+		}
+	}
+}
+
+func Test_compare(t *testing.T) {
+	type args struct {
+		a interface{}
+		b interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "numbers/ large integers",
+			args: args{a: json.Number("9007199254740993"), b: int64(9007199254740992)},
+			want: 1,
+		},
+		{
+			name: "numbers/ integer and float64",
+			args: args{a: int64(9007199254740993), b: 9007199254740994.0},
+			want: -1,
+		},
+		{
+			name: "numbers/ float64",
+			args: args{a: 1.5, b: 1.5},
+			want: 0,
+		},
+		{
+			name: "types",
+			args: args{a: json.Number("1"), b: "1"},
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compare(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package ijson
+
+// SetIfAbsent sets the value at the path only if the path does not exist. A null value is considered present.
+// It reports whether the value was set. See Set() for details.
+func SetIfAbsent(data, value interface{}, path ...string) (interface{}, bool, error) {
+	written := false
+
+	data, err := Update(data, func(old interface{}, exists bool) (interface{}, error) {
+		if exists {
+			return old, nil
+		}
+
+		written = true
+		return value, nil
+	}, path...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, written, nil
+}
+
+// SetIfAbsentP is same as SetIfAbsent(). It just takes `"."` separated path.
+func SetIfAbsentP(data, value interface{}, path string) (interface{}, bool, error) {
+	return SetIfAbsent(data, value, split(path)...)
+}
+
+// SetIfExists sets the value at the path only if the path exists. A null value is considered present.
+// It reports whether the value was set. The data is returned as it is if the path does not exist,
+// including a path through a value that is not an object or array as expected.
+// The path follows Set() syntax, i.e. "#" appends to an array, so it never exists.
+func SetIfExists(data, value interface{}, path ...string) (interface{}, bool, error) {
+	written := false
+
+	result, err := Update(data, func(old interface{}, exists bool) (interface{}, error) {
+		if !exists {
+			// nothing to delete, Update() returns the data as it is
+			return nil, ErrDelete
+		}
+
+		written = true
+		return value, nil
+	}, path...)
+	if absent(err) {
+		return data, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return result, written, nil
+}
+
+// SetIfExistsP is same as SetIfExists(). It just takes `"."` separated path.
+func SetIfExistsP(data, value interface{}, path string) (interface{}, bool, error) {
+	return SetIfExists(data, value, split(path)...)
+}
+
+// CompareAndSet sets the value at the path only if the existing value is deeply equal to expected.
+// Numbers are compared by value irrespective of their type, i.e. int(1) equals float64(1).
+// It reports whether the value was set. The data is returned as it is if the path does not exist.
+// The path follows Set() syntax, see SetIfExists().
+func CompareAndSet(data, expected, value interface{}, path ...string) (interface{}, bool, error) {
+	written := false
+
+	result, err := Update(data, func(old interface{}, exists bool) (interface{}, error) {
+		if !exists {
+			// nothing to delete, Update() returns the data as it is
+			return nil, ErrDelete
+		}
+
+		if !equal(old, expected) {
+			return old, nil
+		}
+
+		written = true
+		return value, nil
+	}, path...)
+	if absent(err) {
+		return data, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return result, written, nil
+}
+
+// CompareAndSetP is same as CompareAndSet(). It just takes `"."` separated path.
+func CompareAndSetP(data, expected, value interface{}, path string) (interface{}, bool, error) {
+	return CompareAndSet(data, expected, value, split(path)...)
+}
+
+// absent reports whether the error of Update() means that the path does not exist,
+// i.e. the path passes through a value that is not an object or array as expected.
+func absent(err error) bool {
+	return err == errExpObj || err == errExpArr
+}
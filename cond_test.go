@@ -0,0 +1,241 @@
+package ijson
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSetIfAbsent(t *testing.T) {
+	type args struct {
+		data  interface{}
+		value interface{}
+		path  []string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        interface{}
+		wantWritten bool
+		wantErr     bool
+	}{
+		{
+			name:        "absent field",
+			args:        args{data: map[string]interface{}{"id": 1}, value: "tom", path: []string{"name"}},
+			want:        map[string]interface{}{"id": 1, "name": "tom"},
+			wantWritten: true,
+		},
+		{
+			name:        "existing field",
+			args:        args{data: map[string]interface{}{"name": "jerry"}, value: "tom", path: []string{"name"}},
+			want:        map[string]interface{}{"name": "jerry"},
+			wantWritten: false,
+		},
+		{
+			name:        "existing null field",
+			args:        args{data: map[string]interface{}{"name": nil}, value: "tom", path: []string{"name"}},
+			want:        map[string]interface{}{"name": nil},
+			wantWritten: false,
+		},
+		{
+			name:        "absent index",
+			args:        args{data: []interface{}{1}, value: 2, path: []string{"#1"}},
+			want:        []interface{}{1, 2},
+			wantWritten: true,
+		},
+		{
+			name:    "type mismatch",
+			args:    args{data: []interface{}{1}, value: 2, path: []string{"name"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, written, err := SetIfAbsent(tt.args.data, tt.args.value, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetIfAbsent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetIfAbsent() = %v, want %v", got, tt.want)
+			}
+			if written != tt.wantWritten {
+				t.Errorf("SetIfAbsent() written = %v, want %v", written, tt.wantWritten)
+			}
+		})
+	}
+}
+
+func TestSetIfExists(t *testing.T) {
+	type args struct {
+		data  interface{}
+		value interface{}
+		path  string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        interface{}
+		wantWritten bool
+	}{
+		{
+			name:        "existing field",
+			args:        args{data: map[string]interface{}{"name": "jerry"}, value: "tom", path: "name"},
+			want:        map[string]interface{}{"name": "tom"},
+			wantWritten: true,
+		},
+		{
+			name:        "absent nested field",
+			args:        args{data: map[string]interface{}{"id": 1}, value: "tom", path: "name.first"},
+			want:        map[string]interface{}{"id": 1},
+			wantWritten: false,
+		},
+		{
+			name:        "absent index",
+			args:        args{data: []interface{}{1}, value: 2, path: "#1"},
+			want:        []interface{}{1},
+			wantWritten: false,
+		},
+		{
+			name:        "path through a scalar",
+			args:        args{data: map[string]interface{}{"a": 1}, value: "x", path: "a.b"},
+			want:        map[string]interface{}{"a": 1},
+			wantWritten: false,
+		},
+		{
+			name:        "index of an object",
+			args:        args{data: map[string]interface{}{"a": map[string]interface{}{}}, value: "x", path: "a.#0"},
+			want:        map[string]interface{}{"a": map[string]interface{}{}},
+			wantWritten: false,
+		},
+		{
+			name:        "null value",
+			args:        args{data: map[string]interface{}{"tags": nil}, value: "x", path: "tags"},
+			want:        map[string]interface{}{"tags": "x"},
+			wantWritten: true,
+		},
+		{
+			name:        "append is never present",
+			args:        args{data: map[string]interface{}{"tags": nil}, value: "x", path: "tags.#"},
+			want:        map[string]interface{}{"tags": nil},
+			wantWritten: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, written, err := SetIfExistsP(tt.args.data, tt.args.value, tt.args.path)
+			if err != nil {
+				t.Errorf("SetIfExistsP() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetIfExistsP() = %v, want %v", got, tt.want)
+			}
+			if written != tt.wantWritten {
+				t.Errorf("SetIfExistsP() written = %v, want %v", written, tt.wantWritten)
+			}
+		})
+	}
+}
+
+func TestCompareAndSet(t *testing.T) {
+	type args struct {
+		data     interface{}
+		expected interface{}
+		value    interface{}
+		path     []string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        interface{}
+		wantWritten bool
+	}{
+		{
+			name:        "equal number/ different type",
+			args:        args{data: map[string]interface{}{"version": json.Number("1")}, expected: 1, value: 2, path: []string{"version"}},
+			want:        map[string]interface{}{"version": 2},
+			wantWritten: true,
+		},
+		{
+			name:        "equal object",
+			args:        args{data: []interface{}{Object()}, expected: Object(), value: nil, path: []string{"#0"}},
+			want:        []interface{}{nil},
+			wantWritten: true,
+		},
+		{
+			name:        "different value",
+			args:        args{data: map[string]interface{}{"version": 3}, expected: 1, value: 2, path: []string{"version"}},
+			want:        map[string]interface{}{"version": 3},
+			wantWritten: false,
+		},
+		{
+			name:        "missing value",
+			args:        args{data: map[string]interface{}{}, expected: nil, value: 2, path: []string{"version"}},
+			want:        map[string]interface{}{},
+			wantWritten: false,
+		},
+		{
+			name:        "append is never present",
+			args:        args{data: map[string]interface{}{"tags": []interface{}{1, 2}}, expected: 2, value: "x", path: []string{"tags", "#"}},
+			want:        map[string]interface{}{"tags": []interface{}{1, 2}},
+			wantWritten: false,
+		},
+		{
+			name:        "path through a scalar",
+			args:        args{data: map[string]interface{}{"a": 1}, expected: nil, value: 2, path: []string{"a", "b"}},
+			want:        map[string]interface{}{"a": 1},
+			wantWritten: false,
+		},
+		{
+			name:        "existing index",
+			args:        args{data: map[string]interface{}{"tags": []interface{}{1, 2}}, expected: 2, value: "x", path: []string{"tags", "#1"}},
+			want:        map[string]interface{}{"tags": []interface{}{1, "x"}},
+			wantWritten: true,
+		},
+		{
+			name:        "data itself",
+			args:        args{data: 1, expected: 1.0, value: 2},
+			want:        2,
+			wantWritten: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, written, err := CompareAndSet(tt.args.data, tt.args.expected, tt.args.value, tt.args.path...)
+			if err != nil {
+				t.Errorf("CompareAndSet() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CompareAndSet() = %v, want %v", got, tt.want)
+			}
+			if written != tt.wantWritten {
+				t.Errorf("CompareAndSet() written = %v, want %v", written, tt.wantWritten)
+			}
+		})
+	}
+}
+
+func TestResult_CompareAndSet(t *testing.T) {
+	r, written := New(map[string]interface{}{"status": "draft"}).CompareAndSetP("draft", "published", "status")
+	if !written {
+		t.Errorf("Result.CompareAndSetP() written = false, want true")
+	}
+
+	r, written = r.SetIfAbsentP("tom", "author.name")
+	if !written {
+		t.Errorf("Result.SetIfAbsentP() written = false, want true")
+	}
+
+	r, written = r.SetIfExistsP("jerry", "editor")
+	if written {
+		t.Errorf("Result.SetIfExistsP() written = true, want false")
+	}
+
+	want := Result{val: map[string]interface{}{"status": "published", "author": map[string]interface{}{"name": "tom"}}}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("Result = %v, want %v", r, want)
+	}
+}
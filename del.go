@@ -1,9 +1,53 @@
 package ijson
 
+// DelOptions controls the behaviour of DelWithOptions().
+// The zero value behaves same as Del().
+type DelOptions struct {
+	Strict bool // report the missing field or index as ErrNotFound instead of ignoring it
+}
+
+// delOpts controls the behaviour of del().
+type delOpts struct {
+	DelOptions
+
+	clone bool // copy the objects and arrays on the path instead of modifying them in place
+}
+
 // Del deletes element form the the data pointed by the path.
 // An error is returned if it fails to resolve the path.
+// A missing field is ignored, use DelWithOptions() to report it.
+// "#" in the middle of the path refers to the last element of the array, i.e. "friends.#.name" deletes
+// "name" from the last friend, the element itself is kept.
 func Del(data interface{}, path ...string) (interface{}, error) {
-	if len(path) == 0 || data == nil {
+	return del(data, delOpts{}, path...)
+}
+
+// DelP is same as Del() function. It just takes `"."` separated path.
+func DelP(data interface{}, path string) (interface{}, error) {
+	return Del(data, split(path)...)
+}
+
+// DelWithOptions is same as Del(). It just changes the behaviour as per opts.
+// With Strict option, ErrNotFound is returned if any field or index on the path does not exists.
+func DelWithOptions(data interface{}, opts DelOptions, path ...string) (interface{}, error) {
+	return del(data, delOpts{DelOptions: opts}, path...)
+}
+
+// DelWithOptionsP is same as DelWithOptions(). It just takes `"."` separated path.
+func DelWithOptionsP(data interface{}, opts DelOptions, path string) (interface{}, error) {
+	return DelWithOptions(data, opts, split(path)...)
+}
+
+func del(data interface{}, o delOpts, path ...string) (interface{}, error) {
+	if len(path) == 0 {
+		return data, nil
+	}
+
+	if data == nil {
+		if o.Strict {
+			return nil, ErrNotFound
+		}
+
 		return data, nil
 	}
 
@@ -15,12 +59,25 @@ func Del(data interface{}, path ...string) (interface{}, error) {
 			return nil, errExpObj
 		}
 
+		elem, exists := object[path[0]]
+		if !exists {
+			if o.Strict {
+				return nil, ErrNotFound
+			}
+
+			return object, nil
+		}
+
+		if o.clone {
+			object = cloneObject(object)
+		}
+
 		if len(path) == 1 {
 			delete(object, path[0])
 			return object, nil
 		}
 
-		newData, err := Del(object[path[0]], path[1:]...)
+		newData, err := del(elem, o, path[1:]...)
 		if err != nil {
 			return nil, err
 		}
@@ -39,11 +96,25 @@ func Del(data interface{}, path ...string) (interface{}, error) {
 			return nil, errExpArr
 		}
 
+		if idx < 0 || idx >= len(array) {
+			if o.Strict {
+				return nil, ErrNotFound
+			}
+
+			if len(path) > 1 {
+				return nil, errOutBnd
+			}
+		}
+
+		if o.clone {
+			array = cloneArray(array)
+		}
+
 		if len(path) == 1 {
 			return DeleteAtArrayIndex(array, idx, pathType == PDel_ArrIdxPO)
 		}
 
-		newData, err := Del(array[idx], path[1:]...)
+		newData, err := del(array[idx], o, path[1:]...)
 		if err != nil {
 			return nil, err
 		}
@@ -58,6 +129,32 @@ func Del(data interface{}, path ...string) (interface{}, error) {
 		}
 
 		l := len(array)
+		if l == 0 {
+			if o.Strict {
+				return nil, ErrNotFound
+			}
+
+			return array, nil
+		}
+
+		if len(path) > 1 {
+			newData, err := del(array[l-1], o, path[1:]...)
+			if err != nil {
+				return nil, err
+			}
+
+			if o.clone {
+				array = cloneArray(array)
+			}
+
+			array[l-1] = newData
+			return array, nil
+		}
+
+		if o.clone {
+			return cloneArray(array[:l-1]), nil
+		}
+
 		array[l-1] = nil
 		return array[:l-1], nil
 
@@ -66,9 +163,42 @@ func Del(data interface{}, path ...string) (interface{}, error) {
 	}
 }
 
-// DelP is same as Del() function. It just takes `"."` separated path.
-func DelP(data interface{}, path string) (interface{}, error) {
-	return Del(data, split(path)...)
+// lookup returns the value pointed by the path, the path follows Del() syntax.
+// An error is returned if it fails to resolve the path.
+func lookup(data interface{}, path ...string) (interface{}, error) {
+	var err error
+
+	for i := range path {
+		switch pathType := DetectDelPath(path[i]); pathType {
+		case PDel_Obj:
+			data, err = GetObject(data, path[i])
+
+		case PDel_ArrIdx, PDel_ArrIdxPO:
+			idx, idxErr := index(path[i], pathType)
+			if idxErr != nil {
+				return nil, idxErr
+			}
+
+			data, err = GetArrayIndex(data, idx)
+
+		case PDel_ArrEnd:
+			array, valid := data.([]interface{})
+			if !valid {
+				return nil, errExpArr
+			}
+
+			data, err = GetArrayIndex(array, len(array)-1)
+
+		default:
+			return nil, errInvPth
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
 }
 
 // DeleteAtArrayIndex deletes the provides index from array.
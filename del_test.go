@@ -160,6 +160,45 @@ func TestDel(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:    "empty array/ valid delete from end",
+			args:    args{data: []interface{}{}, path: []string{"#"}},
+			want:    []interface{}{},
+			wantErr: false,
+		},
+		{
+			name:    "array/ nested delete from end",
+			args:    args{data: []interface{}{1, map[string]interface{}{"a": 1, "b": 2}}, path: []string{"#", "a"}},
+			want:    []interface{}{1, map[string]interface{}{"b": 2}},
+			wantErr: false,
+		},
+		{
+			name: "object/ nested delete from end keeps the element",
+			args: args{
+				data: map[string]interface{}{"arr": []interface{}{map[string]interface{}{"x": 1}, map[string]interface{}{"x": 2, "y": 3}}},
+				path: []string{"arr", "#", "x"},
+			},
+			want:    map[string]interface{}{"arr": []interface{}{map[string]interface{}{"x": 1}, map[string]interface{}{"y": 3}}},
+			wantErr: false,
+		},
+		{
+			name:    "array/ nested index out of range",
+			args:    args{data: []interface{}{map[string]interface{}{"a": 1}}, path: []string{"#1", "a"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "array/ negative index",
+			args:    args{data: []interface{}{map[string]interface{}{"a": 1}}, path: []string{"#-1", "a"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "object/ missing nested field",
+			args:    args{data: map[string]interface{}{"a": 1}, path: []string{"b", "c"}},
+			want:    map[string]interface{}{"a": 1},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -174,3 +213,116 @@ func TestDel(t *testing.T) {
 		})
 	}
 }
+
+func TestDelWithOptions(t *testing.T) {
+	type args struct {
+		data interface{}
+		opts DelOptions
+		path []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr error
+	}{
+		{
+			name: "strict/ existing field",
+			args: args{data: map[string]interface{}{"a": 1, "b": 2}, opts: DelOptions{Strict: true}, path: []string{"a"}},
+			want: map[string]interface{}{"b": 2},
+		},
+		{
+			name:    "strict/ missing field",
+			args:    args{data: map[string]interface{}{"a": 1}, opts: DelOptions{Strict: true}, path: []string{"b"}},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "strict/ missing nested field",
+			args:    args{data: map[string]interface{}{"a": map[string]interface{}{}}, opts: DelOptions{Strict: true}, path: []string{"a", "b", "c"}},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "strict/ index out of range",
+			args:    args{data: []interface{}{1}, opts: DelOptions{Strict: true}, path: []string{"#~1"}},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "strict/ delete from end of empty array",
+			args:    args{data: []interface{}{}, opts: DelOptions{Strict: true}, path: []string{"#"}},
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "non strict/ missing field",
+			args: args{data: map[string]interface{}{"a": 1}, path: []string{"b"}},
+			want: map[string]interface{}{"a": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DelWithOptions(tt.args.data, tt.args.opts, tt.args.path...)
+			if err != tt.wantErr {
+				t.Errorf("DelWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DelWithOptions() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_lookup(t *testing.T) {
+	type args struct {
+		data interface{}
+		path []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "index",
+			args:    args{data: Array(), path: []string{"#1", "name"}},
+			want:    "Justine Bird",
+			wantErr: false,
+		},
+		{
+			name:    "index PO",
+			args:    args{data: Array(), path: []string{"#~2", "id"}},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "end",
+			args:    args{data: Array(), path: []string{"#", "name"}},
+			want:    "Marianne Rutledge",
+			wantErr: false,
+		},
+		{
+			name:    "end/ empty array",
+			args:    args{data: []interface{}{}, path: []string{"#"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid path",
+			args:    args{data: Array(), path: []string{""}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lookup(tt.args.data, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("lookup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("lookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
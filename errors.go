@@ -2,10 +2,34 @@ package ijson
 
 import "errors"
 
+// ErrNotFound is returned if the field or index pointed by the path does not exists.
+var ErrNotFound = errors.New("field or index does not exists")
+
+// ErrTooLarge is returned by ParseReader() if the payload exceeds the MaxBytes option.
+var ErrTooLarge = errors.New("payload exceeds the size limit")
+
+// ErrTooDeep is returned by ParseReader() if the payload exceeds the MaxDepth option.
+var ErrTooDeep = errors.New("payload exceeds the depth limit")
+
 var (
 	errExpObj = errors.New("expected an object")
 	errExpArr = errors.New("expected an array")
-	errNotFnd = errors.New("field or index does not exists")
+	errExpNum = errors.New("expected a number")
+	errExpCnt = errors.New("expected an object, array or string")
+	errNotFnd = ErrNotFound
 	errOutBnd = errors.New("index out of range")
 	errInvPth = errors.New("invalid path")
+	errEmpArr = errors.New("empty array")
+	errUknMod = errors.New("unknown modifier")
+	errInvArg = errors.New("invalid modifier argument")
+	errInvKey = errors.New("key must be a string, number, bool or null")
+	errDupKey = errors.New("duplicate key")
+	errUknTyp = errors.New("unknown type")
+	errCycle  = errors.New("cyclic reference")
+	errOvrFlw = errors.New("integer overflow")
+	errTypCnf = errors.New("type conflict")
+	errPthOvr = errors.New("paths overlap")
+	errInvCnd = errors.New("invalid condition")
+	errTrlDat = errors.New("invalid data after top-level value")
+	errNoChng = errors.New("no change")
 )
@@ -0,0 +1,180 @@
+package ijson
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FlattenOptions controls the keys produced by Flatten() and parsed by Unflatten().
+type FlattenOptions struct {
+	Separator string // separator between path elements, defaults to "."
+	Escape    bool   // escape "\", Separator and leading "#" in object keys with "\", an empty key is written as "\0"
+	KeepEmpty bool   // keep empty objects and arrays as values, they are dropped otherwise
+}
+
+const (
+	escapeChar = `\`
+	emptyKey   = escapeChar + "0" // escaped empty object key
+)
+
+// Flatten returns a flat object of all the leaf values in data. Keys follow the path syntax,
+// i.e. {"name": {"first": "Tom"}, "friends": [{"id": 1}]} => {"name.first": "Tom", "friends.#0.id": 1}
+//
+// The key is empty if data itself is a leaf value.
+// Object keys that are empty, contain Separator OR start with "#" can not be converted back with Unflatten(),
+// set Escape to handle such keys.
+func Flatten(data interface{}, opts FlattenOptions) map[string]interface{} {
+	opts = opts.defaults()
+
+	result := make(map[string]interface{})
+	flattenTo(result, "", data, opts)
+
+	return result
+}
+
+func flattenTo(dst map[string]interface{}, prefix string, data interface{}, opts FlattenOptions) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			if opts.KeepEmpty {
+				dst[prefix] = map[string]interface{}{}
+			}
+
+			return
+		}
+
+		for k, e := range v {
+			if opts.Escape {
+				k = escapeKey(k, opts.Separator)
+			}
+
+			flattenTo(dst, join(prefix, k, opts.Separator), e, opts)
+		}
+
+	case []interface{}:
+		if len(v) == 0 {
+			if opts.KeepEmpty {
+				dst[prefix] = []interface{}{}
+			}
+
+			return
+		}
+
+		for i, e := range v {
+			flattenTo(dst, join(prefix, PathArrayStartStr+strconv.Itoa(i), opts.Separator), e, opts)
+		}
+
+	default:
+		dst[prefix] = data
+	}
+}
+
+// Unflatten rebuilds the data from a flat object produced by Flatten().
+// Keys are resolved with the Set() path syntax, "#<index>" creates an array and rest of the elements are object keys.
+// If opts.Escape is set, escaped elements are always object keys.
+// Keys are applied in sorted order. An error is returned if any of the keys fails to set.
+func Unflatten(flat map[string]interface{}, opts FlattenOptions) (interface{}, error) {
+	opts = opts.defaults()
+
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var (
+		data interface{}
+		err  error
+	)
+
+	for _, k := range keys {
+		path, literal := splitKey(k, opts)
+
+		data, err = set(data, flat[k], setOpts{keys: literal}, path...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+func (o FlattenOptions) defaults() FlattenOptions {
+	if o.Separator == "" {
+		o.Separator = "."
+	}
+
+	return o
+}
+
+func join(prefix, key, sep string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + sep + key
+}
+
+func escapeKey(k, sep string) string {
+	if k == "" {
+		return emptyKey
+	}
+
+	k = strings.Replace(k, escapeChar, escapeChar+escapeChar, -1)
+	k = strings.Replace(k, sep, escapeChar+sep, -1)
+
+	if strings.HasPrefix(k, PathArrayStartStr) {
+		k = escapeChar + k
+	}
+
+	return k
+}
+
+// splitKey splits the flat key in path elements, honoring the escapes if opts.Escape is set.
+// If escaped, keys[i] tells whether path[i] is an object key, the element follows Set() path syntax otherwise.
+func splitKey(k string, opts FlattenOptions) (path []string, keys []bool) {
+	if k == "" {
+		return nil, nil
+	}
+
+	if !opts.Escape {
+		return strings.Split(k, opts.Separator), nil
+	}
+
+	var (
+		cur strings.Builder
+		arr bool
+	)
+
+	start := true // at the start of an element
+	for i := 0; i < len(k); i++ {
+		switch {
+		case strings.HasPrefix(k[i:], escapeChar+escapeChar):
+			cur.WriteString(escapeChar)
+			i++
+		case strings.HasPrefix(k[i:], escapeChar+opts.Separator):
+			cur.WriteString(opts.Separator)
+			i += len(opts.Separator)
+		case strings.HasPrefix(k[i:], escapeChar+PathArrayStartStr):
+			cur.WriteString(PathArrayStartStr)
+			i++
+		case start && strings.HasPrefix(k[i:], emptyKey):
+			i += len(emptyKey) - 1
+		case strings.HasPrefix(k[i:], opts.Separator):
+			path, keys = append(path, cur.String()), append(keys, !arr)
+			cur.Reset()
+			arr, start = false, true
+			i += len(opts.Separator) - 1
+			continue
+		default:
+			arr = arr || (start && k[i] == PathArrayStart)
+			cur.WriteByte(k[i])
+		}
+
+		start = false
+	}
+
+	return append(path, cur.String()), append(keys, !arr)
+}
@@ -0,0 +1,188 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	type args struct {
+		data interface{}
+		opts FlattenOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "nested",
+			args: args{data: []interface{}{map[string]interface{}{"friends": Array()}}},
+			want: map[string]interface{}{
+				"#0.friends.#0.id":   0,
+				"#0.friends.#0.name": "Justine Bird",
+				"#0.friends.#1.id":   0,
+				"#0.friends.#1.name": "Justine Bird",
+				"#0.friends.#2.id":   1,
+				"#0.friends.#2.name": "Marianne Rutledge",
+			},
+		},
+		{
+			name: "custom separator with escape",
+			args: args{
+				data: map[string]interface{}{"a/b": map[string]interface{}{`c\d`: 1}},
+				opts: FlattenOptions{Separator: "/", Escape: true},
+			},
+			want: map[string]interface{}{`a\/b/c\\d`: 1},
+		},
+		{
+			name: "empty containers dropped",
+			args: args{data: map[string]interface{}{"a": []interface{}{}, "b": map[string]interface{}{}, "c": nil}},
+			want: map[string]interface{}{"c": nil},
+		},
+		{
+			name: "empty containers kept",
+			args: args{
+				data: map[string]interface{}{"a": []interface{}{}, "b": map[string]interface{}{}},
+				opts: FlattenOptions{KeepEmpty: true},
+			},
+			want: map[string]interface{}{"a": []interface{}{}, "b": map[string]interface{}{}},
+		},
+		{
+			name: "scalar",
+			args: args{data: 1},
+			want: map[string]interface{}{"": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Flatten(tt.args.data, tt.args.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Flatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnflatten(t *testing.T) {
+	type args struct {
+		flat map[string]interface{}
+		opts FlattenOptions
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "objects and arrays",
+			args: args{flat: map[string]interface{}{
+				"name.first":      "Tom",
+				"friends.#1.id":   2,
+				"friends.#0.id":   1,
+				"friends.#0.tags": []interface{}{},
+			}},
+			want: map[string]interface{}{
+				"name": map[string]interface{}{"first": "Tom"},
+				"friends": []interface{}{
+					map[string]interface{}{"id": 1, "tags": []interface{}{}},
+					map[string]interface{}{"id": 2},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "set path syntax",
+			args:    args{flat: map[string]interface{}{"tags.#": "a", "ids.#+0": 1}},
+			want:    map[string]interface{}{"tags": []interface{}{"a"}, "ids": []interface{}{1}},
+			wantErr: false,
+		},
+		{
+			name: "custom separator with escape",
+			args: args{
+				flat: map[string]interface{}{`a\/b/c\\d`: 1},
+				opts: FlattenOptions{Separator: "/", Escape: true},
+			},
+			want:    map[string]interface{}{"a/b": map[string]interface{}{`c\d`: 1}},
+			wantErr: false,
+		},
+		{
+			name: "escaped keys",
+			args: args{
+				flat: map[string]interface{}{`\#x.#0`: 1, `a.\0`: 2},
+				opts: FlattenOptions{Escape: true},
+			},
+			want:    map[string]interface{}{"#x": []interface{}{1}, "a": map[string]interface{}{"": 2}},
+			wantErr: false,
+		},
+		{
+			name:    "scalar",
+			args:    args{flat: map[string]interface{}{"": 1}},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "conflicting keys",
+			args:    args{flat: map[string]interface{}{"a.b": 1, "a.#0": 1}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid index",
+			args:    args{flat: map[string]interface{}{"a.#b": 1}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Unflatten(tt.args.flat, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unflatten() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unflatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatten_roundTrip(t *testing.T) {
+	data := []interface{}{map[string]interface{}{"friends": Array(), "name": "tom"}}
+
+	got, err := Unflatten(Flatten(data, FlattenOptions{}), FlattenOptions{})
+	if err != nil {
+		t.Fatalf("Unflatten() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("Unflatten() = %v, want %v", got, data)
+	}
+}
+
+func TestFlatten_roundTripEscape(t *testing.T) {
+	tests := []struct {
+		name string
+		data interface{}
+	}{
+		{name: "leading #", data: map[string]interface{}{"#x": 2, "#0": []interface{}{"#1"}, "a#": 3}},
+		{name: "empty keys", data: map[string]interface{}{"a": map[string]interface{}{"": 1}, "": map[string]interface{}{"": 2}}},
+		{name: "escape characters", data: map[string]interface{}{`\`: 1, `\0`: 2, `\#`: 3, "a.b": map[string]interface{}{".": 4}}},
+		{name: "arrays", data: []interface{}{map[string]interface{}{"#": []interface{}{1, map[string]interface{}{"": nil}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, opts := range []FlattenOptions{{Escape: true}, {Escape: true, Separator: "/"}} {
+				flat := Flatten(tt.data, opts)
+
+				got, err := Unflatten(flat, opts)
+				if err != nil {
+					t.Fatalf("Unflatten(%v) error = %v", flat, err)
+				}
+				if !reflect.DeepEqual(got, tt.data) {
+					t.Errorf("Unflatten(%v) = %v, want %v", flat, got, tt.data)
+				}
+			}
+		})
+	}
+}
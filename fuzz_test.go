@@ -0,0 +1,105 @@
+package ijson
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// fuzzElems are the path elements used to build random paths, valid for at least one of Get, Set or Del.
+var fuzzElems = []string{
+	"", "a", "b", "c", "#", "#0", "#1", "#2", "#5", "#-1", "#9223372036854775807", "#4000000000", "#17592186044415", "#17592186044416", "#x", "#~", "#~a", "#~0", "#~1", "#~-1", "#~x",
+	"#+", "#+0", "#+1", "#+5", "#+-1", "#+9223372036854775807", "@", "@count", "@sum", "@avg", "@min", "@max", "@distinct",
+	"@flatten", "@flatten:-1", "@flatten:x", "@reverse", "@first", "@last", "@keys", "@values", "@len",
+	"@sort", "@sort:-a", "@sort:a,", "@unique", "@unique:a,#0", "@unknown", "@@", "@@a",
+}
+
+// fuzzTree returns a random tree of objects, arrays and scalars.
+func fuzzTree(r *rand.Rand, depth int) interface{} {
+	n := 4
+	if depth > 0 {
+		n = 6
+	}
+
+	switch r.Intn(n) {
+	case 0:
+		return nil
+	case 1:
+		return r.Intn(3) == 0
+	case 2:
+		return float64(r.Intn(10))
+	case 3:
+		return string(rune('a' + r.Intn(3)))
+	case 4:
+		object := make(map[string]interface{})
+		for i, l := 0, r.Intn(4); i < l; i++ {
+			object[string(rune('a'+r.Intn(3)))] = fuzzTree(r, depth-1)
+		}
+
+		return object
+	default:
+		array := make([]interface{}, r.Intn(4))
+		for i := range array {
+			array[i] = fuzzTree(r, depth-1)
+		}
+
+		return array
+	}
+}
+
+func fuzzPath(r *rand.Rand) []string {
+	path := make([]string, r.Intn(5))
+	for i := range path {
+		path[i] = fuzzElems[r.Intn(len(fuzzElems))]
+	}
+
+	return path
+}
+
+// TestFuzz calls the Get, Set and Del entry points with random paths and trees,
+// none of them must panic whatever the input is.
+func TestFuzz(t *testing.T) {
+	n := 20000
+	if testing.Short() {
+		n = 2000
+	}
+
+	ops := []struct {
+		name string
+		fn   func(data interface{}, path []string) (interface{}, error)
+	}{
+		{"Get", func(data interface{}, path []string) (interface{}, error) { return Get(data, path...) }},
+		{"GetP", func(data interface{}, path []string) (interface{}, error) { return GetP(data, strings.Join(path, ".")) }},
+		{"Set", func(data interface{}, path []string) (interface{}, error) { return Set(data, 1, path...) }},
+		{"SetF", func(data interface{}, path []string) (interface{}, error) { return SetF(data, 1, path...) }},
+		{"SetWithOptions", func(data interface{}, path []string) (interface{}, error) {
+			return SetWithOptions(data, 1, SetOptions{MaxGrowth: 2, NoCreate: len(path)%2 == 0, Fill: 0}, path...)
+		}},
+		{"SetImmutable", func(data interface{}, path []string) (interface{}, error) { return SetImmutable(data, 1, path...) }},
+		{"Del", func(data interface{}, path []string) (interface{}, error) { return Del(data, path...) }},
+		{"DelP", func(data interface{}, path []string) (interface{}, error) { return DelP(data, strings.Join(path, ".")) }},
+		{"DelWithOptions", func(data interface{}, path []string) (interface{}, error) {
+			return DelWithOptions(data, DelOptions{Strict: true}, path...)
+		}},
+		{"DelImmutable", func(data interface{}, path []string) (interface{}, error) { return DelImmutable(data, path...) }},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < n; i++ {
+		for _, op := range ops {
+			data, path := fuzzTree(r, 3), fuzzPath(r)
+			in := fmt.Sprintf("%v", data)
+
+			func() {
+				defer func() {
+					if e := recover(); e != nil {
+						t.Fatalf("%s(%s, %q) panicked: %v", op.name, in, path, e)
+					}
+				}()
+
+				_, _ = op.fn(data, path)
+			}()
+		}
+	}
+}
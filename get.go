@@ -13,6 +13,10 @@ package ijson
 //  "#~name" - return an array of all the objects having "name" field.
 //  "#" - return the length of the result array
 //
+// A path element starting with "@" applies a modifier to the current result,
+// see GetModifier() for the list of supported modifiers. Use "@@" to get an object field
+// starting with "@", i.e. "@@type" gets the "@type" field.
+//
 func Get(data interface{}, path ...string) (interface{}, error) {
 	var err error
 
@@ -20,10 +24,10 @@ func Get(data interface{}, path ...string) (interface{}, error) {
 
 		switch pathType := DetectGetPath(path[i]); pathType {
 		case PGet_Obj:
-			data, err = GetObject(data, path[i])
+			data, err = GetObject(data, objKey(path[i]))
 
 		case PGet_ArrIdx:
-			idx, idxErr := index(path[0], PGet_ArrIdx)
+			idx, idxErr := index(path[i], PGet_ArrIdx)
 			if idxErr != nil {
 				return nil, idxErr
 			}
@@ -36,6 +40,9 @@ func Get(data interface{}, path ...string) (interface{}, error) {
 		case PGet_ArrLen:
 			return GetArrayLen(data)
 
+		case PGet_Mod:
+			data, err = GetModifier(data, path[i])
+
 		case P_Unknown:
 			return nil, errInvPth
 		}
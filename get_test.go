@@ -52,12 +52,36 @@ func TestGet(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:    "nested/ valid index after field",
+			args:    args{data: Nested(), path: []string{"#0", "friends", "#2", "name"}},
+			want:    "Marianne Rutledge",
+			wantErr: false,
+		},
 		{
 			name:    "nil/ valid index",
 			args:    args{data: nil, path: []string{"#2"}},
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:    "object/ escaped field starting with @",
+			args:    args{data: map[string]interface{}{"@type": "user", "@@id": 1}, path: []string{"@@type"}},
+			want:    "user",
+			wantErr: false,
+		},
+		{
+			name:    "object/ escaped field starting with @@",
+			args:    args{data: map[string]interface{}{"@type": "user", "@@id": 1}, path: []string{"@@@id"}},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "object/ unescaped field starting with @",
+			args:    args{data: map[string]interface{}{"@type": "user"}, path: []string{"@type"}},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -73,6 +97,36 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestGetP(t *testing.T) {
+	data := map[string]interface{}{
+		"@context": map[string]interface{}{"@type": "user"},
+		"items":    []interface{}{3, 1, 2},
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "escaped fields", path: "@@context.@@type", want: "user"},
+		{name: "modifier", path: "items.@max", want: 3},
+		{name: "unknown modifier", path: "@context", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetP(data, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetP() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetObject(t *testing.T) {
 	type args struct {
 		data  interface{}
@@ -0,0 +1,86 @@
+package ijson
+
+import "strconv"
+
+// GroupBy groups the elements of array by the value present at `"."` separated keyPath in each element.
+// It returns an object of arrays, elements in each group keep their order from the input array.
+// Key values are converted to string, i.e. 1 => "1", true => "true", null => "null".
+// An error is returned if the input is not an array, the keyPath can not be resolved for any of the elements
+// OR the key is an object or an array.
+func GroupBy(array interface{}, keyPath string) (map[string]interface{}, error) {
+	arr, valid := array.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	result := make(map[string]interface{})
+	for i := range arr {
+		k, err := key(arr[i], keyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		group, _ := result[k].([]interface{})
+		result[k] = append(group, arr[i])
+	}
+
+	return result, nil
+}
+
+// KeyBy indexes the elements of array by the value present at `"."` separated keyPath in each element.
+// It returns an object of elements. See GroupBy() for details about keys.
+// An error is returned on duplicate keys, along with the conditions described for GroupBy().
+func KeyBy(array interface{}, keyPath string) (map[string]interface{}, error) {
+	arr, valid := array.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	result := make(map[string]interface{}, len(arr))
+	for i := range arr {
+		k, err := key(arr[i], keyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, exists := result[k]; exists {
+			return nil, errDupKey
+		}
+
+		result[k] = arr[i]
+	}
+
+	return result, nil
+}
+
+// key resolves the keyPath in data and returns its string representation.
+func key(data interface{}, keyPath string) (string, error) {
+	v, err := GetP(data, keyPath)
+	if err != nil {
+		return "", err
+	}
+
+	return keyString(v)
+}
+
+// keyString returns string representation of a scalar value to be used as an object key.
+func keyString(v interface{}) (string, error) {
+	switch k := v.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return k, nil
+	case bool:
+		return strconv.FormatBool(k), nil
+	}
+
+	if n, ok := parseNum(v); ok {
+		if n.i != nil {
+			return n.i.String(), nil
+		}
+
+		return strconv.FormatFloat(n.f, 'f', -1, 64), nil
+	}
+
+	return "", errInvKey
+}
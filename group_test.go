@@ -0,0 +1,153 @@
+package ijson
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	a := Array()
+
+	type args struct {
+		array   interface{}
+		keyPath string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "group by number",
+			args: args{array: a, keyPath: "id"},
+			want: map[string]interface{}{
+				"0": []interface{}{a[0], a[1]},
+				"1": []interface{}{a[2]},
+			},
+			wantErr: false,
+		},
+		{
+			name: "group by nested path",
+			args: args{array: Nested(), keyPath: "friends.#2.name"},
+			want: map[string]interface{}{
+				"Marianne Rutledge": []interface{}{Nested().([]interface{})[0]},
+			},
+			wantErr: false,
+		},
+		{
+			name: "group by null and bool",
+			args: args{array: []interface{}{
+				map[string]interface{}{"ok": true},
+				map[string]interface{}{"ok": nil},
+			}, keyPath: "ok"},
+			want: map[string]interface{}{
+				"true": []interface{}{map[string]interface{}{"ok": true}},
+				"null": []interface{}{map[string]interface{}{"ok": nil}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing key",
+			args:    args{array: a, keyPath: "age"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "object key",
+			args:    args{array: []interface{}{map[string]interface{}{"id": Object()}}, keyPath: "id"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "not an array",
+			args:    args{array: Object(), keyPath: "id"},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GroupBy(tt.args.array, tt.args.keyPath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GroupBy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	a := Array()
+	ids := []interface{}{
+		map[string]interface{}{"id": int64(9007199254740992)},
+		map[string]interface{}{"id": json.Number("9007199254740993")},
+	}
+
+	type args struct {
+		array   interface{}
+		keyPath string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "duplicate keys",
+			args:    args{array: a, keyPath: "name"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "unique keys",
+			args: args{array: a[1:], keyPath: "id"},
+			want: map[string]interface{}{
+				"0": a[1],
+				"1": a[2],
+			},
+			wantErr: false,
+		},
+		{
+			name: "large integer keys",
+			args: args{array: ids, keyPath: "id"},
+			want: map[string]interface{}{
+				"9007199254740992": ids[0],
+				"9007199254740993": ids[1],
+			},
+			wantErr: false,
+		},
+		{
+			name:    "not an array",
+			args:    args{array: nil, keyPath: "id"},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := KeyBy(tt.args.array, tt.args.keyPath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("KeyBy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeyBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_GroupBy(t *testing.T) {
+	got := New(Nested()).GetP("#0.friends").GroupBy("name").Get("Justine Bird", "#")
+
+	want := Result{val: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.GroupBy() = %v, want %v", got, want)
+	}
+}
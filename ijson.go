@@ -42,6 +42,24 @@ func (r Result) Error() error {
 	return r.err
 }
 
+// Clone returns a result with deep copy of the value. See Clone() function for details.
+func (r Result) Clone() Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	return Result{val: Clone(r.val)}
+}
+
+// Kind returns the kind of the value. Returns Kind_Missing if the result has an error.
+func (r Result) Kind() Kind {
+	if r.Error() != nil {
+		return Kind_Missing
+	}
+
+	return KindOf(r.val)
+}
+
 func (r Result) GetP(path string) Result {
 	return r.get(split(path)...)
 }
@@ -84,6 +102,177 @@ func (r Result) set(value interface{}, path ...string) Result {
 	return Result{val: data}
 }
 
+func (r Result) SetWithOptionsP(value interface{}, opts SetOptions, path string) Result {
+	return r.setWithOptions(value, opts, split(path)...)
+}
+
+func (r Result) SetWithOptions(value interface{}, opts SetOptions, path ...string) Result {
+	return r.setWithOptions(value, opts, path...)
+}
+
+func (r Result) setWithOptions(value interface{}, opts SetOptions, path ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := SetWithOptions(r.val, value, opts, path...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "SET"}}
+	}
+
+	return Result{val: data}
+}
+
+func (r Result) SetImmutableP(value interface{}, path string) Result {
+	return r.setImmutable(value, split(path)...)
+}
+
+func (r Result) SetImmutable(value interface{}, path ...string) Result {
+	return r.setImmutable(value, path...)
+}
+
+func (r Result) setImmutable(value interface{}, path ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := SetImmutable(r.val, value, path...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "SET"}}
+	}
+
+	return Result{val: data}
+}
+
+func (r Result) UpdateP(fn UpdateFunc, path string) Result {
+	return r.update(fn, split(path)...)
+}
+
+func (r Result) Update(fn UpdateFunc, path ...string) Result {
+	return r.update(fn, path...)
+}
+
+func (r Result) update(fn UpdateFunc, path ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := Update(r.val, fn, path...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "UPDATE"}}
+	}
+
+	return Result{val: data}
+}
+
+func (r Result) IncrP(delta interface{}, path string) Result {
+	return r.arith(delta, opAdd, split(path)...)
+}
+
+func (r Result) Incr(delta interface{}, path ...string) Result {
+	return r.arith(delta, opAdd, path...)
+}
+
+func (r Result) MulP(factor interface{}, path string) Result {
+	return r.arith(factor, opMul, split(path)...)
+}
+
+func (r Result) Mul(factor interface{}, path ...string) Result {
+	return r.arith(factor, opMul, path...)
+}
+
+func (r Result) MinP(bound interface{}, path string) Result {
+	return r.arith(bound, opMin, split(path)...)
+}
+
+func (r Result) Min(bound interface{}, path ...string) Result {
+	return r.arith(bound, opMin, path...)
+}
+
+func (r Result) MaxP(bound interface{}, path string) Result {
+	return r.arith(bound, opMax, split(path)...)
+}
+
+func (r Result) Max(bound interface{}, path ...string) Result {
+	return r.arith(bound, opMax, path...)
+}
+
+func (r Result) arith(operand interface{}, op arithOp, path ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := arithmetic(r.val, operand, op, path...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "UPDATE"}}
+	}
+
+	return Result{val: data}
+}
+
+// SetIfAbsent sets the value if path does not exist, it reports whether the value was set.
+// See SetIfAbsent() function for details.
+func (r Result) SetIfAbsent(value interface{}, path ...string) (Result, bool) {
+	return r.cond(func(data interface{}) (interface{}, bool, error) {
+		return SetIfAbsent(data, value, path...)
+	})
+}
+
+func (r Result) SetIfAbsentP(value interface{}, path string) (Result, bool) {
+	return r.SetIfAbsent(value, split(path)...)
+}
+
+// SetIfExists sets the value if path exists, it reports whether the value was set.
+// See SetIfExists() function for details.
+func (r Result) SetIfExists(value interface{}, path ...string) (Result, bool) {
+	return r.cond(func(data interface{}) (interface{}, bool, error) {
+		return SetIfExists(data, value, path...)
+	})
+}
+
+func (r Result) SetIfExistsP(value interface{}, path string) (Result, bool) {
+	return r.SetIfExists(value, split(path)...)
+}
+
+// CompareAndSet sets the value if existing value equals expected, it reports whether the value was set.
+// See CompareAndSet() function for details.
+func (r Result) CompareAndSet(expected, value interface{}, path ...string) (Result, bool) {
+	return r.cond(func(data interface{}) (interface{}, bool, error) {
+		return CompareAndSet(data, expected, value, path...)
+	})
+}
+
+func (r Result) CompareAndSetP(expected, value interface{}, path string) (Result, bool) {
+	return r.CompareAndSet(expected, value, split(path)...)
+}
+
+func (r Result) cond(fn func(data interface{}) (interface{}, bool, error)) (Result, bool) {
+	if r.Error() != nil {
+		return r, false
+	}
+
+	data, written, err := fn(r.val)
+	if err != nil {
+		return Result{err: Err{o: err, a: "SET"}}, false
+	}
+
+	return Result{val: data}, written
+}
+
+// SetMany sets all the assignments or none of them. See SetMany() function for details.
+func (r Result) SetMany(assignments []Assignment) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := SetMany(r.val, assignments)
+	if err != nil {
+		return Result{err: Err{o: err, a: "SET"}}
+	}
+
+	return Result{val: data}
+}
+
 func (r Result) DelP(path string) Result {
 	return r.del(split(path)...)
 }
@@ -91,6 +280,27 @@ func (r Result) DelP(path string) Result {
 func (r Result) Del(path ...string) Result {
 	return r.del(path...)
 }
+
+func (r Result) DelWithOptionsP(opts DelOptions, path string) Result {
+	return r.delWithOptions(opts, split(path)...)
+}
+
+func (r Result) DelWithOptions(opts DelOptions, path ...string) Result {
+	return r.delWithOptions(opts, path...)
+}
+
+func (r Result) delWithOptions(opts DelOptions, path ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := DelWithOptions(r.val, opts, path...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "DELETE"}}
+	}
+
+	return Result{val: data}
+}
 func (r Result) del(path ...string) Result {
 	if r.Error() != nil {
 		return r
@@ -104,6 +314,238 @@ func (r Result) del(path ...string) Result {
 	return Result{val: data}
 }
 
+// Take deletes the element pointed by the path and returns it along with the result.
+// See Take() function for details.
+func (r Result) Take(path ...string) (Result, interface{}) {
+	if r.Error() != nil {
+		return r, nil
+	}
+
+	data, removed, err := Take(r.val, path...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "DELETE"}}, nil
+	}
+
+	return Result{val: data}, removed
+}
+
+func (r Result) TakeP(path string) (Result, interface{}) {
+	return r.Take(split(path)...)
+}
+
+// DelWhere deletes the array elements for which pred holds and returns the number of deleted elements
+// along with the result. See DelWhere() function for details.
+func (r Result) DelWhere(arrayPath string, pred Predicate) (Result, int) {
+	if r.Error() != nil {
+		return r, 0
+	}
+
+	data, n, err := DelWhere(r.val, arrayPath, pred)
+	if err != nil {
+		return Result{err: Err{o: err, a: "DELETE"}}, 0
+	}
+
+	return Result{val: data}, n
+}
+
+func (r Result) DelImmutableP(path string) Result {
+	return r.delImmutable(split(path)...)
+}
+
+func (r Result) DelImmutable(path ...string) Result {
+	return r.delImmutable(path...)
+}
+
+func (r Result) delImmutable(path ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := DelImmutable(r.val, path...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "DELETE"}}
+	}
+
+	return Result{val: data}
+}
+
+func (r Result) Move(from, to string) Result {
+	return r.relocate("MOVE", func(data interface{}) (interface{}, error) { return Move(data, from, to) })
+}
+
+func (r Result) Copy(from, to string) Result {
+	return r.relocate("COPY", func(data interface{}) (interface{}, error) { return Copy(data, from, to) })
+}
+
+func (r Result) Rename(path, newKey string) Result {
+	return r.relocate("RENAME", func(data interface{}) (interface{}, error) { return Rename(data, path, newKey) })
+}
+
+func (r Result) Swap(a, b string) Result {
+	return r.relocate("SWAP", func(data interface{}) (interface{}, error) { return Swap(data, a, b) })
+}
+
+func (r Result) relocate(action string, fn func(data interface{}) (interface{}, error)) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := fn(r.val)
+	if err != nil {
+		return Result{err: Err{o: err, a: action}}
+	}
+
+	return Result{val: data}
+}
+
+// Compact recursively drops the values as per opts. See Compact() function for details.
+func (r Result) Compact(opts CompactOptions) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	return Result{val: Compact(r.val, opts)}
+}
+
+// Merge deeply merges src into the value. See Merge() function for details.
+func (r Result) Merge(src interface{}, opts MergeOptions) Result {
+	return r.merge(src, opts, Merge)
+}
+
+// MergeImmutable deeply merges src into a copy of the value. See MergeImmutable() function for details.
+func (r Result) MergeImmutable(src interface{}, opts MergeOptions) Result {
+	return r.merge(src, opts, MergeImmutable)
+}
+
+func (r Result) merge(src interface{}, opts MergeOptions, fn func(dst, src interface{}, opts MergeOptions) (interface{}, error)) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := fn(r.val, src, opts)
+	if err != nil {
+		return Result{err: Err{o: err, a: "MERGE"}}
+	}
+
+	return Result{val: data}
+}
+
+// Sort sorts the array present at `"."` separated arrayPath in place. See Sort() function for details.
+func (r Result) Sort(arrayPath string, keys ...SortKey) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := Sort(r.val, arrayPath, keys...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "SORT"}}
+	}
+
+	return Result{val: data}
+}
+
+// Unique removes the duplicate elements of the array present at `"."` separated arrayPath. See Unique() function for details.
+func (r Result) Unique(arrayPath string, keyPaths ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := Unique(r.val, arrayPath, keyPaths...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "UNIQUE"}}
+	}
+
+	return Result{val: data}
+}
+
+// Union returns the elements present in the value OR other. See Union() function for details.
+func (r Result) Union(other Result, keyPaths ...string) Result {
+	return r.setOp(other, keyPaths, "UNION", Union)
+}
+
+// Intersect returns the elements of the value that are present in other. See Intersect() function for details.
+func (r Result) Intersect(other Result, keyPaths ...string) Result {
+	return r.setOp(other, keyPaths, "INTERSECT", Intersect)
+}
+
+// Difference returns the elements of the value that are not present in other. See Difference() function for details.
+func (r Result) Difference(other Result, keyPaths ...string) Result {
+	return r.setOp(other, keyPaths, "DIFFERENCE", Difference)
+}
+
+func (r Result) setOp(other Result, keyPaths []string, action string, fn func(a, b interface{}, keyPaths ...string) ([]interface{}, error)) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	if other.Error() != nil {
+		return other
+	}
+
+	data, err := fn(r.val, other.val, keyPaths...)
+	if err != nil {
+		return Result{err: Err{o: err, a: action}}
+	}
+
+	return Result{val: data}
+}
+
+// GroupBy groups the array elements by `"."` separated keyPath. See GroupBy() function for details.
+func (r Result) GroupBy(keyPath string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := GroupBy(r.val, keyPath)
+	if err != nil {
+		return Result{err: Err{o: err, a: "GROUP"}}
+	}
+
+	return Result{val: data}
+}
+
+// KeyBy indexes the array elements by `"."` separated keyPath. See KeyBy() function for details.
+func (r Result) KeyBy(keyPath string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := KeyBy(r.val, keyPath)
+	if err != nil {
+		return Result{err: Err{o: err, a: "KEY"}}
+	}
+
+	return Result{val: data}
+}
+
+// Pick keeps only the provided `"."` separated paths in the value. See Pick() function for details.
+func (r Result) Pick(paths ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := Pick(r.val, paths...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "PICK"}}
+	}
+
+	return Result{val: data}
+}
+
+// Omit removes the provided `"."` separated paths from the value. See Omit() function for details.
+func (r Result) Omit(paths ...string) Result {
+	if r.Error() != nil {
+		return r
+	}
+
+	data, err := Omit(r.val, paths...)
+	if err != nil {
+		return Result{err: Err{o: err, a: "OMIT"}}
+	}
+
+	return Result{val: data}
+}
+
 func (e Err) Error() string {
 	return fmt.Sprintf("failed to %s : %v", e.a, e.o)
 }
@@ -130,3 +130,12 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestResult_SetP(t *testing.T) {
+	got := New(Nested()).Clone().SetP("Tom", "#0.friends.#+0.name").GetP("#0.friends.#~name")
+
+	want := Result{val: []interface{}{"Tom", "Justine Bird", "Justine Bird", "Marianne Rutledge"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.SetP() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,23 @@
+package ijson
+
+// SetImmutable is same as Set(). It just never modifies the input data.
+// Only the objects and arrays on the path are copied, rest of the data is shared with the result.
+func SetImmutable(data, value interface{}, path ...string) (interface{}, error) {
+	return set(data, value, setOpts{clone: true}, path...)
+}
+
+// SetImmutableP is same as SetImmutable(). It just takes `"."` separated path.
+func SetImmutableP(data, value interface{}, path string) (interface{}, error) {
+	return setP(data, value, setOpts{clone: true}, path)
+}
+
+// DelImmutable is same as Del(). It just never modifies the input data.
+// Only the objects and arrays on the path are copied, rest of the data is shared with the result.
+func DelImmutable(data interface{}, path ...string) (interface{}, error) {
+	return del(data, delOpts{clone: true}, path...)
+}
+
+// DelImmutableP is same as DelImmutable(). It just takes `"."` separated path.
+func DelImmutableP(data interface{}, path string) (interface{}, error) {
+	return DelImmutable(data, split(path)...)
+}
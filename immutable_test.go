@@ -0,0 +1,179 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func template() map[string]interface{} {
+	return map[string]interface{}{
+		"name":    "tom",
+		"friends": []interface{}{"jerry", "spike", "tyke"},
+		"address": map[string]interface{}{"city": "pune"},
+	}
+}
+
+func TestSetImmutable(t *testing.T) {
+	type args struct {
+		value interface{}
+		path  []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "object/ nested field",
+			args: args{value: "mumbai", path: []string{"address", "city"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"friends": []interface{}{"jerry", "spike", "tyke"},
+				"address": map[string]interface{}{"city": "mumbai"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "array/ index",
+			args: args{value: "butch", path: []string{"friends", "#4"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"friends": []interface{}{"jerry", "spike", "tyke", nil, "butch"},
+				"address": map[string]interface{}{"city": "pune"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "array/ append",
+			args: args{value: "butch", path: []string{"friends", "#"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"friends": []interface{}{"jerry", "spike", "tyke", "butch"},
+				"address": map[string]interface{}{"city": "pune"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "array/ insert",
+			args: args{value: "butch", path: []string{"friends", "#+1"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"friends": []interface{}{"jerry", "butch", "spike", "tyke"},
+				"address": map[string]interface{}{"city": "pune"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid path",
+			args:    args{value: "butch", path: []string{"name", "#"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := template()
+			got, err := SetImmutable(data, tt.args.value, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetImmutable() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetImmutable() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(data, template()) {
+				t.Errorf("SetImmutable() modified the input = %v", data)
+			}
+		})
+	}
+}
+
+func TestDelImmutable(t *testing.T) {
+	type args struct {
+		path []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "object/ nested field",
+			args: args{path: []string{"address", "city"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"friends": []interface{}{"jerry", "spike", "tyke"},
+				"address": map[string]interface{}{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "array/ index",
+			args: args{path: []string{"friends", "#0"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"friends": []interface{}{"tyke", "spike"},
+				"address": map[string]interface{}{"city": "pune"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "array/ index PO",
+			args: args{path: []string{"friends", "#~0"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"friends": []interface{}{"spike", "tyke"},
+				"address": map[string]interface{}{"city": "pune"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "array/ end",
+			args: args{path: []string{"friends", "#"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"friends": []interface{}{"jerry", "spike"},
+				"address": map[string]interface{}{"city": "pune"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid path",
+			args:    args{path: []string{"friends", "name"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := template()
+			got, err := DelImmutable(data, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DelImmutable() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DelImmutable() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(data, template()) {
+				t.Errorf("DelImmutable() modified the input = %v", data)
+			}
+		})
+	}
+}
+
+func TestResult_Immutable(t *testing.T) {
+	data := template()
+
+	got := New(data).SetImmutableP("mumbai", "address.city").DelImmutableP("friends.#~0").GetP("friends.#0")
+
+	want := Result{val: "spike"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.SetImmutable() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(data, template()) {
+		t.Errorf("Result.SetImmutable() modified the input = %v", data)
+	}
+}
@@ -0,0 +1,83 @@
+package ijson
+
+import "reflect"
+
+// Kind is the JSON type of a value.
+type Kind uint
+
+const (
+	Kind_Unknown Kind = iota // Value of an unknown Go type
+	Kind_Missing             // Path does not exist
+	Kind_Null                // nil
+	Kind_Bool                // bool
+	Kind_Number              // any Go numeric type OR json.Number
+	Kind_String              // string
+	Kind_Array               // []interface{} OR any other slice
+	Kind_Object              // map[string]interface{} OR any other map with string keys
+)
+
+var kindNames = [...]string{
+	Kind_Unknown: "unknown",
+	Kind_Missing: "missing",
+	Kind_Null:    "null",
+	Kind_Bool:    "bool",
+	Kind_Number:  "number",
+	Kind_String:  "string",
+	Kind_Array:   "array",
+	Kind_Object:  "object",
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+
+	return kindNames[Kind_Unknown]
+}
+
+// TypeOf returns the kind of the value present at the path.
+// Returns Kind_Missing if it fails to resolve the path.
+func TypeOf(data interface{}, path ...string) Kind {
+	v, err := Get(data, path...)
+	if err != nil {
+		return Kind_Missing
+	}
+
+	return KindOf(v)
+}
+
+// TypeOfP is same as TypeOf(). It just takes `"."` separated path.
+func TypeOfP(data interface{}, path string) Kind {
+	return TypeOf(data, split(path)...)
+}
+
+// KindOf returns the kind of the value.
+func KindOf(v interface{}) Kind {
+	switch v.(type) {
+	case nil:
+		return Kind_Null
+	case bool:
+		return Kind_Bool
+	case string:
+		return Kind_String
+	case []interface{}:
+		return Kind_Array
+	case map[string]interface{}:
+		return Kind_Object
+	}
+
+	if isNumber(v) {
+		return Kind_Number
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return Kind_Array
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			return Kind_Object
+		}
+	}
+
+	return Kind_Unknown
+}
@@ -0,0 +1,112 @@
+package ijson
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTypeOf(t *testing.T) {
+	type args struct {
+		data interface{}
+		path []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want Kind
+	}{
+		{
+			name: "object",
+			args: args{data: Nested(), path: []string{"#0"}},
+			want: Kind_Object,
+		},
+		{
+			name: "array",
+			args: args{data: Nested(), path: []string{"#0", "friends"}},
+			want: Kind_Array,
+		},
+		{
+			name: "slice of strings",
+			args: args{data: Nested(), path: []string{"#0", "tags"}},
+			want: Kind_Array,
+		},
+		{
+			name: "string",
+			args: args{data: Nested(), path: []string{"#0", "name"}},
+			want: Kind_String,
+		},
+		{
+			name: "int",
+			args: args{data: Nested(), path: []string{"#0", "age"}},
+			want: Kind_Number,
+		},
+		{
+			name: "float",
+			args: args{data: Nested(), path: []string{"#0", "latitude"}},
+			want: Kind_Number,
+		},
+		{
+			name: "json number",
+			args: args{data: json.Number("1e3")},
+			want: Kind_Number,
+		},
+		{
+			name: "uint8",
+			args: args{data: uint8(1)},
+			want: Kind_Number,
+		},
+		{
+			name: "bool",
+			args: args{data: Nested(), path: []string{"#0", "isActive"}},
+			want: Kind_Bool,
+		},
+		{
+			name: "null",
+			args: args{data: map[string]interface{}{"a": nil}, path: []string{"a"}},
+			want: Kind_Null,
+		},
+		{
+			name: "missing",
+			args: args{data: Nested(), path: []string{"#0", "qualification"}},
+			want: Kind_Missing,
+		},
+		{
+			name: "unknown",
+			args: args{data: struct{}{}},
+			want: Kind_Unknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TypeOf(tt.args.data, tt.args.path...); got != tt.want {
+				t.Errorf("TypeOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Kind(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Result
+		want Kind
+	}{
+		{
+			name: "valid result",
+			r:    New(Object()).Get("id"),
+			want: Kind_Number,
+		},
+		{
+			name: "result with error",
+			r:    New(Object()).Get("#0"),
+			want: Kind_Missing,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Kind(); got != tt.want {
+				t.Errorf("Result.Kind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package ijson
+
+// Assignment is a value to be set at the path.
+type Assignment struct {
+	Path  []string
+	Value interface{}
+}
+
+// SetMany sets all the assignments in order, as Set() does. It either applies all the assignments or none.
+// The input data is never modified, the objects and arrays on the assignment paths are copied before
+// setting the values, rest of the data is shared with the result.
+// An error is returned for the first assignment that fails, leaving the input data as it was.
+func SetMany(data interface{}, assignments []Assignment) (interface{}, error) {
+	var err error
+	for i := range assignments {
+		data, err = set(data, assignments[i].Value, setOpts{clone: true}, assignments[i].Path...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
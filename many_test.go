@@ -0,0 +1,122 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetMany(t *testing.T) {
+	type args struct {
+		data        interface{}
+		assignments []Assignment
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "all valid",
+			args: args{
+				data: Object(),
+				assignments: []Assignment{
+					{Path: []string{"name"}, Value: "tom"},
+					{Path: []string{"tags", "#"}, Value: "cat"},
+					{Path: []string{"friends", "#1", "name"}, Value: "jerry"},
+				},
+			},
+			want: map[string]interface{}{
+				"id":      0,
+				"name":    "tom",
+				"tags":    []interface{}{"cat"},
+				"friends": []interface{}{nil, map[string]interface{}{"name": "jerry"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil data",
+			args: args{
+				data: nil,
+				assignments: []Assignment{
+					{Path: []string{"#1"}, Value: 1},
+					{Path: []string{"#0"}, Value: 0},
+				},
+			},
+			want:    []interface{}{0, 1},
+			wantErr: false,
+		},
+		{
+			name: "failing assignment",
+			args: args{
+				data: Object(),
+				assignments: []Assignment{
+					{Path: []string{"name"}, Value: "tom"},
+					{Path: []string{"id", "value"}, Value: 1},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "no assignments",
+			args:    args{data: Object()},
+			want:    Object(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SetMany(tt.args.data, tt.args.assignments)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetMany() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetMany() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetMany_rollback(t *testing.T) {
+	friends := make([]interface{}, 1, 4)
+	friends[0] = "tom"
+	data := map[string]interface{}{
+		"name":    "spike",
+		"friends": friends,
+		"nested":  map[string]interface{}{"id": 1},
+	}
+
+	_, err := SetMany(data, []Assignment{
+		{Path: []string{"name"}, Value: "tyke"},
+		{Path: []string{"friends", "#"}, Value: "jerry"},
+		{Path: []string{"nested", "id"}, Value: 2},
+		{Path: []string{"friends", "name"}, Value: "jerry"},
+	})
+	if err == nil {
+		t.Fatalf("SetMany() error = nil, want error")
+	}
+
+	want := map[string]interface{}{
+		"name":    "spike",
+		"friends": []interface{}{"tom"},
+		"nested":  map[string]interface{}{"id": 1},
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("SetMany() modified the input = %v, want %v", data, want)
+	}
+
+	if friends[:2][1] != nil {
+		t.Errorf("SetMany() modified the backing array = %v", friends[:2])
+	}
+}
+
+func TestResult_SetMany(t *testing.T) {
+	got := New(Object()).SetMany([]Assignment{{Path: []string{"name"}, Value: "tom"}}).Get("name")
+
+	want := Result{val: "tom"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.SetMany() = %v, want %v", got, want)
+	}
+}
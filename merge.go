@@ -0,0 +1,194 @@
+package ijson
+
+// ArrayStrategy decides how Merge() combines two arrays.
+type ArrayStrategy uint
+
+const (
+	Merge_Replace ArrayStrategy = iota // Replace the dst array with src array
+	Merge_Append                       // Append src elements to dst array
+	Merge_ByIndex                      // Merge the elements at same index, extra src elements are appended
+	Merge_ByKey                        // Merge the objects having same value at MergeOptions.ArrayKey, others are appended
+)
+
+// ConflictPolicy decides what Merge() does when dst and src values differ in type
+// and at least one of them is an object or an array.
+type ConflictPolicy uint
+
+const (
+	Conflict_Replace ConflictPolicy = iota // Replace dst value with src value
+	Conflict_Keep                          // Keep dst value
+	Conflict_Error                         // Return an error
+)
+
+// MergeOptions controls the behaviour of Merge().
+type MergeOptions struct {
+	Arrays      ArrayStrategy  // how to combine arrays, Merge_Replace by default
+	ArrayKey    string         // `"."` separated path of the key within array elements, for Merge_ByKey
+	Conflict    ConflictPolicy // what to do on type conflicts, Conflict_Replace by default
+	NullDeletes bool           // null in src object deletes the field from dst object
+}
+
+// Merge deeply merges src into dst. Objects are merged recursively, arrays are combined
+// as per opts.Arrays. Scalar values in src replace the values in dst.
+// dst is modified in place and the merged data is returned. Values from src are not copied,
+// they are shared with the result. Use MergeImmutable() to keep the inputs as they are.
+// An error is returned on type conflicts with Conflict_Error policy OR if ArrayKey is empty with Merge_ByKey strategy.
+// dst is not modified if an error is returned.
+func Merge(dst, src interface{}, opts MergeOptions) (interface{}, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	// look for conflicts on copies first, so that dst is not left partially merged
+	if opts.Conflict == Conflict_Error {
+		if _, err := merge(Clone(dst), Clone(src), opts); err != nil {
+			return nil, err
+		}
+	}
+
+	return merge(dst, src, opts)
+}
+
+// MergeImmutable is same as Merge(). It just never modifies the inputs,
+// the result does not share any object or array with them.
+func MergeImmutable(dst, src interface{}, opts MergeOptions) (interface{}, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	return merge(Clone(dst), Clone(src), opts)
+}
+
+func (o MergeOptions) validate() error {
+	if o.Arrays == Merge_ByKey && o.ArrayKey == "" {
+		return errInvPth
+	}
+
+	return nil
+}
+
+func merge(dst, src interface{}, opts MergeOptions) (interface{}, error) {
+	if dst == nil {
+		return src, nil
+	}
+
+	switch s := src.(type) {
+	case map[string]interface{}:
+		d, ok := dst.(map[string]interface{})
+		if !ok {
+			return conflict(dst, src, opts)
+		}
+
+		return mergeObject(d, s, opts)
+
+	case []interface{}:
+		d, ok := dst.([]interface{})
+		if !ok {
+			return conflict(dst, src, opts)
+		}
+
+		return mergeArray(d, s, opts)
+	}
+
+	switch dst.(type) {
+	case map[string]interface{}, []interface{}:
+		return conflict(dst, src, opts)
+	}
+
+	return src, nil
+}
+
+func mergeObject(dst, src map[string]interface{}, opts MergeOptions) (interface{}, error) {
+	for k, v := range src {
+		if v == nil && opts.NullDeletes {
+			delete(dst, k)
+			continue
+		}
+
+		old, exists := dst[k]
+		if !exists {
+			dst[k] = v
+			continue
+		}
+
+		merged, err := merge(old, v, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		dst[k] = merged
+	}
+
+	return dst, nil
+}
+
+func mergeArray(dst, src []interface{}, opts MergeOptions) (interface{}, error) {
+	switch opts.Arrays {
+	case Merge_Append:
+		return append(dst, src...), nil
+
+	case Merge_ByIndex:
+		for i := range src {
+			if i >= len(dst) {
+				dst = append(dst, src[i])
+				continue
+			}
+
+			merged, err := merge(dst[i], src[i], opts)
+			if err != nil {
+				return nil, err
+			}
+
+			dst[i] = merged
+		}
+
+		return dst, nil
+
+	case Merge_ByKey:
+		for i := range src {
+			j := -1
+			if k, err := GetP(src[i], opts.ArrayKey); err == nil {
+				j = findByKey(dst, k, opts.ArrayKey)
+			}
+
+			if j < 0 {
+				dst = append(dst, src[i])
+				continue
+			}
+
+			merged, err := merge(dst[j], src[i], opts)
+			if err != nil {
+				return nil, err
+			}
+
+			dst[j] = merged
+		}
+
+		return dst, nil
+
+	default:
+		return src, nil
+	}
+}
+
+// findByKey returns index of the first element having value k at keyPath, -1 if not found.
+func findByKey(array []interface{}, k interface{}, keyPath string) int {
+	for i := range array {
+		if v, err := GetP(array[i], keyPath); err == nil && equal(v, k) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func conflict(dst, src interface{}, opts MergeOptions) (interface{}, error) {
+	switch opts.Conflict {
+	case Conflict_Keep:
+		return dst, nil
+	case Conflict_Error:
+		return nil, errTypCnf
+	default:
+		return src, nil
+	}
+}
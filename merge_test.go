@@ -0,0 +1,187 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func baseConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"name":  "app",
+		"debug": false,
+		"db":    map[string]interface{}{"host": "localhost", "port": 5432},
+		"tags":  []interface{}{"a", "b"},
+		"users": []interface{}{
+			map[string]interface{}{"id": 1, "role": "admin"},
+			map[string]interface{}{"id": 2, "role": "user"},
+		},
+	}
+}
+
+func TestMerge(t *testing.T) {
+	type args struct {
+		src  interface{}
+		opts MergeOptions
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "objects/ recursive",
+			args: args{src: map[string]interface{}{"debug": true, "db": map[string]interface{}{"host": "db"}}},
+			want: func() interface{} {
+				c := baseConfig()
+				c["debug"] = true
+				c["db"] = map[string]interface{}{"host": "db", "port": 5432}
+				return c
+			}(),
+		},
+		{
+			name: "arrays/ replace",
+			args: args{src: map[string]interface{}{"tags": []interface{}{"c"}}},
+			want: func() interface{} {
+				c := baseConfig()
+				c["tags"] = []interface{}{"c"}
+				return c
+			}(),
+		},
+		{
+			name: "arrays/ append",
+			args: args{src: map[string]interface{}{"tags": []interface{}{"c"}}, opts: MergeOptions{Arrays: Merge_Append}},
+			want: func() interface{} {
+				c := baseConfig()
+				c["tags"] = []interface{}{"a", "b", "c"}
+				return c
+			}(),
+		},
+		{
+			name: "arrays/ by index",
+			args: args{src: map[string]interface{}{"tags": []interface{}{"x", "b", "c"}}, opts: MergeOptions{Arrays: Merge_ByIndex}},
+			want: func() interface{} {
+				c := baseConfig()
+				c["tags"] = []interface{}{"x", "b", "c"}
+				return c
+			}(),
+		},
+		{
+			name: "arrays/ by key",
+			args: args{
+				src: map[string]interface{}{"users": []interface{}{
+					map[string]interface{}{"id": 2.0, "role": "admin"},
+					map[string]interface{}{"id": 3, "role": "user"},
+				}},
+				opts: MergeOptions{Arrays: Merge_ByKey, ArrayKey: "id"},
+			},
+			want: func() interface{} {
+				c := baseConfig()
+				c["users"] = []interface{}{
+					map[string]interface{}{"id": 1, "role": "admin"},
+					map[string]interface{}{"id": 2.0, "role": "admin"},
+					map[string]interface{}{"id": 3, "role": "user"},
+				}
+				return c
+			}(),
+		},
+		{
+			name: "null deletes",
+			args: args{src: map[string]interface{}{"db": nil, "name": nil}, opts: MergeOptions{NullDeletes: true}},
+			want: func() interface{} {
+				c := baseConfig()
+				delete(c, "db")
+				delete(c, "name")
+				return c
+			}(),
+		},
+		{
+			name: "null replaces",
+			args: args{src: map[string]interface{}{"name": nil}},
+			want: func() interface{} {
+				c := baseConfig()
+				c["name"] = nil
+				return c
+			}(),
+		},
+		{
+			name: "conflict/ replace",
+			args: args{src: map[string]interface{}{"db": "postgres://db"}},
+			want: func() interface{} {
+				c := baseConfig()
+				c["db"] = "postgres://db"
+				return c
+			}(),
+		},
+		{
+			name: "conflict/ keep",
+			args: args{src: map[string]interface{}{"db": []interface{}{}}, opts: MergeOptions{Conflict: Conflict_Keep}},
+			want: baseConfig(),
+		},
+		{
+			name:    "by key/ empty key",
+			args:    args{src: map[string]interface{}{"name": "b"}, opts: MergeOptions{Arrays: Merge_ByKey}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "conflict/ error",
+			args:    args{src: map[string]interface{}{"tags": "a"}, opts: MergeOptions{Conflict: Conflict_Error}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Merge(baseConfig(), tt.args.src, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Merge() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Merge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerge_conflictKeepsDst(t *testing.T) {
+	dst := baseConfig()
+	src := map[string]interface{}{"name": "new", "debug": true, "db": map[string]interface{}{"port": 6432}, "tags": "a"}
+
+	if _, err := Merge(dst, src, MergeOptions{Conflict: Conflict_Error}); err == nil {
+		t.Fatalf("Merge() error = nil, want conflict")
+	}
+
+	if !reflect.DeepEqual(dst, baseConfig()) {
+		t.Errorf("Merge() modified dst = %v", dst)
+	}
+}
+
+func TestMergeImmutable(t *testing.T) {
+	dst := baseConfig()
+	src := map[string]interface{}{"db": map[string]interface{}{"host": "db"}, "extra": map[string]interface{}{"a": 1}}
+
+	got, err := MergeImmutable(dst, src, MergeOptions{Arrays: Merge_Append})
+	if err != nil {
+		t.Fatalf("MergeImmutable() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(dst, baseConfig()) {
+		t.Errorf("MergeImmutable() modified dst = %v", dst)
+	}
+
+	got.(map[string]interface{})["extra"].(map[string]interface{})["a"] = 2
+	if src["extra"].(map[string]interface{})["a"] != 1 {
+		t.Errorf("MergeImmutable() shares the src data")
+	}
+}
+
+func TestResult_Merge(t *testing.T) {
+	got := New(baseConfig()).Merge(map[string]interface{}{"db": map[string]interface{}{"port": 6432}}, MergeOptions{}).GetP("db.port")
+
+	want := Result{val: 6432}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Merge() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+package ijson
+
+import "strings"
+
+// modifier transforms the data as per the arg.
+// arg is the part after ":" in "@<name>:<arg>", it is empty if not provided.
+type modifier func(data interface{}, arg string) (interface{}, error)
+
+var modifiers map[string]modifier
+
+func init() {
+	// populated in init to avoid initialization cycle, modifiers may call Get() internally.
+	modifiers = map[string]modifier{
+		"count":    modCount,
+		"sum":      modSum,
+		"avg":      modAvg,
+		"min":      modMin,
+		"max":      modMax,
+		"distinct": modDistinct,
+		"flatten":  modFlatten,
+		"reverse":  modReverse,
+		"first":    modFirst,
+		"last":     modLast,
+		"keys":     modKeys,
+		"values":   modValues,
+		"len":      modLen,
+		"sort":     modSort,
+		"unique":   modUnique,
+	}
+}
+
+// GetModifier applies the provided modifier to the data and returns the result.
+// Modifier syntax is "@<name>" OR "@<name>:<arg>". Key paths in arguments separate the elements with "/",
+// i.e. "@sort:name/first", so that they can be used in `"."` separated paths.
+// An error will be returned for an unknown modifier OR if the data is not suitable for it.
+//
+// Aggregate modifiers, these expect the input data to be an array -
+//
+//  "@count" - number of elements in the array, zero if the data is nil
+//  "@sum" - sum of all the numbers, exact int64 if all of them are integers, float64 otherwise
+//  "@avg" - average of all the numbers as float64, integers are summed exactly before the division
+//  "@min" - smallest number as it is present in the array
+//  "@max" - largest number as it is present in the array
+//  "@distinct" - array of distinct values in order of their first occurrence
+//
+// Reshaping modifiers -
+//
+//  "@flatten" - unwrap the nested arrays by one level, "@flatten:<depth>" for deeper levels
+//  "@reverse" - new array with elements in reverse order
+//  "@first" - first element of the array
+//  "@last" - last element of the array
+//  "@keys" - array of object keys, sorted in ascending order
+//  "@values" - array of object values, in order of "@keys"
+//  "@len" - length of an array, object OR string (in runes), zero if the data is nil
+//  "@sort" - new sorted array, "@sort:<key>,-<key>" to sort by keys, "-" for descending order
+//  "@unique" - new array without duplicates, "@unique:<key>,<key>" to compare by keys
+//
+func GetModifier(data interface{}, mod string) (interface{}, error) {
+	if len(mod) == 0 || mod[0] != PathModifier {
+		return nil, errInvPth
+	}
+
+	name, arg := mod[1:], ""
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		name, arg = name[:i], name[i+1:]
+	}
+
+	fn, exists := modifiers[name]
+	if !exists {
+		return nil, errUknMod
+	}
+
+	return fn(data, arg)
+}
+
+// argPath converts a key path in modifier argument to `"."` separated path.
+// The elements can be separated by PathArgSepStr as well as ".".
+func argPath(p string) string {
+	return strings.Replace(p, PathArgSepStr, ".", -1)
+}
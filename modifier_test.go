@@ -0,0 +1,56 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetModifier(t *testing.T) {
+	type args struct {
+		data interface{}
+		mod  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid modifier",
+			args:    args{data: Array(), mod: "@count"},
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name:    "unknown modifier",
+			args:    args{data: Array(), mod: "@unknown"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty modifier",
+			args:    args{data: Array(), mod: "@"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "not a modifier",
+			args:    args{data: Array(), mod: "count"},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetModifier(tt.args.data, tt.args.mod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetModifier() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetModifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package ijson
+
+// Move moves the value from `"."` separated path from to path to. The value is deleted from
+// its source before it is set at the destination, as Del() and Set() do. So from follows Del() path syntax
+// and to follows Set() path syntax.
+// Both the paths are resolved before modifying anything, the input data is never modified.
+// Moving a value to the same path is a no-op.
+// An error is returned if the from path does not exist, to path can not be set, to is within from
+// OR from is an array element and to is within the same array.
+func Move(data interface{}, from, to string) (interface{}, error) {
+	return move(data, split(from), split(to))
+}
+
+// Copy copies a deep copy of the value at `"."` separated path from to path to.
+// The input data is never modified. An error is returned if the from path does not exist OR to path can not be set.
+func Copy(data interface{}, from, to string) (interface{}, error) {
+	v, err := GetP(data, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return SetImmutableP(data, Clone(v), to)
+}
+
+// Rename renames the object field at `"."` separated path to newKey, the field stays in the same object.
+// An existing field with newKey is replaced. The input data is never modified.
+// An error is returned if the path does not point to an object field OR newKey is not a valid object key.
+func Rename(data interface{}, path, newKey string) (interface{}, error) {
+	from := split(path)
+
+	last := from[len(from)-1]
+	if DetectSetPath(last) != PSet_Obj || DetectSetPath(newKey) != PSet_Obj {
+		return nil, errInvPth
+	}
+
+	to := make([]string, len(from))
+	copy(to, from)
+	to[len(to)-1] = newKey
+
+	return move(data, from, to)
+}
+
+// Swap swaps the values at `"."` separated paths a and b. The input data is never modified.
+// The paths may only contain object fields and array indexes, i.e. "#<index>", as they are both read and set.
+// An error is returned if any of the paths does not exist, is not valid OR one of them is within the other.
+func Swap(data interface{}, a, b string) (interface{}, error) {
+	pa, pb := split(a), split(b)
+	if !plain(pa) || !plain(pb) {
+		return nil, errInvPth
+	}
+
+	if within(pa, pb) || within(pb, pa) {
+		return nil, errPthOvr
+	}
+
+	va, err := Get(data, pa...)
+	if err != nil {
+		return nil, err
+	}
+
+	vb, err := Get(data, pb...)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = SetImmutable(data, vb, setPath(pa)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return SetImmutable(data, va, setPath(pb)...)
+}
+
+func move(data interface{}, from, to []string) (interface{}, error) {
+	v, err := lookup(data, from...)
+	if err != nil {
+		return nil, err
+	}
+
+	if within(to, from) {
+		if len(to) == len(from) {
+			return data, nil
+		}
+
+		return nil, errPthOvr
+	}
+
+	// deleting an array element shifts the other elements, to would point to a different element
+	parent := from[:len(from)-1]
+	if DetectDelPath(from[len(from)-1]) != PDel_Obj && len(to) > len(parent) && within(to, parent) {
+		return nil, errPthOvr
+	}
+
+	data, err = DelImmutable(data, from...)
+	if err != nil {
+		return nil, err
+	}
+
+	return SetImmutable(data, v, to...)
+}
+
+// plain reports whether the path contains only object fields and array indexes,
+// the elements having the same meaning in Get() and Set() syntax.
+func plain(path []string) bool {
+	for i := range path {
+		switch DetectGetPath(path[i]) {
+		case PGet_Obj:
+		case PGet_ArrIdx:
+			if DetectSetPath(path[i]) != PSet_ArrIdx {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// setPath converts the plain Get() path p to Set() syntax, removing the escape of object keys starting with "@".
+func setPath(p []string) []string {
+	s := make([]string, len(p))
+	for i := range p {
+		s[i] = objKey(p[i])
+	}
+
+	return s
+}
+
+// within reports whether path p is same as OR nested within the path parent.
+func within(p, parent []string) bool {
+	if len(p) < len(parent) {
+		return false
+	}
+
+	for i := range parent {
+		if p[i] != parent[i] {
+			return false
+		}
+	}
+
+	return true
+}
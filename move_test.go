@@ -0,0 +1,303 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func payload() map[string]interface{} {
+	return map[string]interface{}{
+		"name":    "tom",
+		"address": map[string]interface{}{"city": "pune"},
+		"friends": []interface{}{"jerry", "spike"},
+	}
+}
+
+func TestMove(t *testing.T) {
+	type args struct {
+		from string
+		to   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "field to nested field",
+			args: args{from: "name", to: "profile.name"},
+			want: map[string]interface{}{
+				"profile": map[string]interface{}{"name": "tom"},
+				"address": map[string]interface{}{"city": "pune"},
+				"friends": []interface{}{"jerry", "spike"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "array element to field",
+			args: args{from: "friends.#~0", to: "best"},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"best":    "jerry",
+				"address": map[string]interface{}{"city": "pune"},
+				"friends": []interface{}{"spike"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "same path",
+			args:    args{from: "name", to: "name"},
+			want:    payload(),
+			wantErr: false,
+		},
+		{
+			name:    "missing source",
+			args:    args{from: "age", to: "profile.age"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid destination",
+			args:    args{from: "address", to: "name.address"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "destination within source",
+			args:    args{from: "address", to: "address.old"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "within same array",
+			args:    args{from: "friends.#0", to: "friends.#1"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "within same array/ append",
+			args:    args{from: "friends.#~0", to: "friends.#"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "array element to nested field of parent",
+			args: args{from: "friends.#1", to: "address.friend"},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"address": map[string]interface{}{"city": "pune", "friend": "spike"},
+				"friends": []interface{}{"jerry"},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := payload()
+			got, err := Move(data, tt.args.from, tt.args.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Move() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Move() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(data, payload()) {
+				t.Errorf("Move() modified the input = %v", data)
+			}
+		})
+	}
+}
+
+func TestCopy(t *testing.T) {
+	data := payload()
+
+	got, err := Copy(data, "address", "office")
+	if err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+
+	want := payload()
+	want["office"] = map[string]interface{}{"city": "pune"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copy() = %v, want %v", got, want)
+	}
+
+	got.(map[string]interface{})["office"].(map[string]interface{})["city"] = "mumbai"
+	if !reflect.DeepEqual(data, payload()) {
+		t.Errorf("Copy() shares the copied value = %v", data)
+	}
+
+	if _, err := Copy(data, "address", "friends.city"); err == nil {
+		t.Errorf("Copy() error = nil, want error for invalid destination")
+	}
+}
+
+func TestRename(t *testing.T) {
+	type args struct {
+		path   string
+		newKey string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "nested field",
+			args: args{path: "address.city", newKey: "town"},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"address": map[string]interface{}{"town": "pune"},
+				"friends": []interface{}{"jerry", "spike"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing field",
+			args:    args{path: "address.zip", newKey: "pin"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "array index",
+			args:    args{path: "friends.#0", newKey: "best"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid key",
+			args:    args{path: "name", newKey: "#0"},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Rename(payload(), tt.args.path, tt.args.newKey)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Rename() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Rename() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSwap(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "array elements",
+			args: args{a: "friends.#0", b: "friends.#1"},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"address": map[string]interface{}{"city": "pune"},
+				"friends": []interface{}{"spike", "jerry"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fields",
+			args: args{a: "name", b: "address.city"},
+			want: map[string]interface{}{
+				"name":    "pune",
+				"address": map[string]interface{}{"city": "tom"},
+				"friends": []interface{}{"jerry", "spike"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing path",
+			args:    args{a: "name", b: "age"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "array append",
+			args:    args{a: "friends.#", b: "name"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "array field",
+			args:    args{a: "friends.#~0", b: "name"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "array insert",
+			args:    args{a: "name", b: "friends.#+0"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "modifier",
+			args:    args{a: "friends.@first", b: "name"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "nested paths",
+			args:    args{a: "address", b: "address.city"},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := payload()
+			got, err := Swap(data, tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Swap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Swap() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(data, payload()) {
+				t.Errorf("Swap() modified the input = %v", data)
+			}
+		})
+	}
+}
+
+func TestSwap_escaped(t *testing.T) {
+	data := map[string]interface{}{"@id": "a1", "name": "tom"}
+
+	got, err := Swap(data, "@@id", "name")
+	if err != nil {
+		t.Fatalf("Swap() error = %v", err)
+	}
+
+	want := map[string]interface{}{"@id": "tom", "name": "a1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Swap() = %v, want %v", got, want)
+	}
+}
+
+func TestResult_Move(t *testing.T) {
+	got := New(payload()).Move("name", "profile.name").Rename("address", "home").Swap("friends.#0", "friends.#1").Copy("home", "office")
+
+	want := Result{val: map[string]interface{}{
+		"profile": map[string]interface{}{"name": "tom"},
+		"home":    map[string]interface{}{"city": "pune"},
+		"office":  map[string]interface{}{"city": "pune"},
+		"friends": []interface{}{"spike", "jerry"},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Move() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package ijson
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+)
+
+// toFloat converts any Go numeric value OR json.Number to float64.
+// ok will be false if the value is not a number.
+func toFloat(v interface{}) (f float64, ok bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case uintptr:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// isNumber reports whether the value is a Go numeric value OR a valid json.Number.
+func isNumber(v interface{}) bool {
+	_, ok := toFloat(v)
+	return ok
+}
+
+// cmpNum returns -1, 0 or +1 depending on whether number a is less than, equal to or greater than number b.
+// Integers are compared exactly, without converting them to float64.
+// ok will be false if any of the values is not a number OR is NaN.
+func cmpNum(a, b interface{}) (c int, ok bool) {
+	// fast path for the numbers decoded by encoding/json
+	if x, isFloat := a.(float64); isFloat {
+		if y, isFloat := b.(float64); isFloat {
+			return cmpFloat(x, y)
+		}
+	}
+
+	x, ok := parseNum(a)
+	if !ok {
+		return 0, false
+	}
+
+	y, ok := parseNum(b)
+	if !ok {
+		return 0, false
+	}
+
+	if x.i != nil && y.i != nil {
+		return x.i.Cmp(y.i), true
+	}
+
+	if x.i == nil && y.i == nil {
+		return cmpFloat(x.f, y.f)
+	}
+
+	if (x.i == nil && math.IsNaN(x.f)) || (y.i == nil && math.IsNaN(y.f)) {
+		return 0, false
+	}
+
+	return x.exact().Cmp(y.exact()), true
+}
+
+func cmpFloat(x, y float64) (int, bool) {
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	case x == y:
+		return 0, true
+	}
+
+	// NaN
+	return 0, false
+}
+
+// exact returns the exact value of n as big.Float.
+func (n num) exact() *big.Float {
+	if n.i != nil {
+		return new(big.Float).SetInt(n.i)
+	}
+
+	return big.NewFloat(n.f)
+}
@@ -0,0 +1,193 @@
+package ijson
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// NumberMode decides how the json numbers are decoded.
+type NumberMode uint
+
+const (
+	Number_Float64 NumberMode = iota // decode all the numbers as float64, same as encoding/json
+	Number_JSON                      // decode all the numbers as json.Number, keeping the original digits
+	Number_Int64                     // decode integers as int64 and rest of the numbers as float64
+)
+
+// ParseOptions controls the behaviour of ParseReader(), ParseFile() and ParseBytesWithOptions().
+// The zero value parses the payload without any limits, same as ParseBytes().
+type ParseOptions struct {
+	MaxBytes int64 // maximum size of the payload in bytes, after decompression. no limit if zero
+	MaxDepth int   // maximum nesting depth of objects and arrays, no limit if zero
+	Gzip     bool  // decompress the payload if it starts with gzip header, it is read as it is otherwise
+
+	// Numbers decides how the numbers are decoded. float64 loses precision for integers beyond 2^53,
+	// use Number_JSON OR Number_Int64 to keep large ids as they are.
+	Numbers NumberMode
+}
+
+// gzipMagic are the first two bytes of a gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ParseReader reads the json from r and parses it as per the opts.
+// It returns ErrTooLarge if the payload exceeds MaxBytes and ErrTooDeep if it exceeds MaxDepth.
+// The payload is not parsed if it violates any of the limits.
+func ParseReader(r io.Reader, opts ParseOptions) Result {
+	if opts.Gzip {
+		br := bufio.NewReader(r)
+		r = br
+
+		if magic, _ := br.Peek(len(gzipMagic)); string(magic) == string(gzipMagic) {
+			zr, err := gzip.NewReader(br)
+			if err != nil {
+				return Result{err: err}
+			}
+			defer zr.Close()
+
+			r = zr
+		}
+	}
+
+	if opts.MaxBytes > 0 {
+		// read one more byte to detect the payload exceeding the limit
+		r = io.LimitReader(r, opts.MaxBytes+1)
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Result{err: err}
+	}
+
+	return parse(data, opts)
+}
+
+// ParseBytesWithOptions is same as ParseBytes(). It just parses the data as per the opts, see ParseReader() for details.
+func ParseBytesWithOptions(data []byte, opts ParseOptions) Result {
+	if opts.Gzip && bytes.HasPrefix(data, gzipMagic) {
+		return ParseReader(bytes.NewReader(data), opts)
+	}
+
+	return parse(data, opts)
+}
+
+// parse checks the limits and parses the uncompressed data.
+func parse(data []byte, opts ParseOptions) Result {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		return Result{err: ErrTooLarge}
+	}
+
+	if opts.MaxDepth > 0 && depth(data) > opts.MaxDepth {
+		return Result{err: ErrTooDeep}
+	}
+
+	res := Result{}
+	res.val, res.err = unmarshal(data, opts.Numbers)
+
+	return res
+}
+
+// ParseFile reads the json from file at path and parses it as per the opts. See ParseReader() for details.
+func ParseFile(path string, opts ParseOptions) Result {
+	f, err := os.Open(path)
+	if err != nil {
+		return Result{err: err}
+	}
+	defer f.Close()
+
+	return ParseReader(f, opts)
+}
+
+// depth returns the maximum nesting depth of objects and arrays in the json.
+// It does not validate the json, brackets within strings are ignored.
+func depth(data []byte) int {
+	var d, max int
+	var str, esc bool
+
+	for _, c := range data {
+		switch {
+		case esc:
+			esc = false
+		case str:
+			switch c {
+			case '\\':
+				esc = true
+			case '"':
+				str = false
+			}
+		case c == '"':
+			str = true
+		case c == '{' || c == '[':
+			d++
+			if d > max {
+				max = d
+			}
+		case c == '}' || c == ']':
+			d--
+		}
+	}
+
+	return max
+}
+
+// unmarshal decodes the json as per the NumberMode.
+// With Number_Int64, integers out of int64 range are decoded as float64.
+func unmarshal(data []byte, mode NumberMode) (interface{}, error) {
+	var v interface{}
+
+	if mode == Number_Float64 {
+		err := json.Unmarshal(data, &v)
+		return v, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	// same as json.Unmarshal, only a single value is allowed
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errTrlDat
+		}
+
+		return nil, err
+	}
+
+	if mode == Number_Int64 {
+		v = toInt64(v)
+	}
+
+	return v, nil
+}
+
+// toInt64 replaces json.Number values in data with int64 if they are integers, float64 otherwise.
+func toInt64(data interface{}) interface{} {
+	switch v := data.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+
+		f, _ := v.Float64()
+		return f
+
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = toInt64(v[k])
+		}
+
+	case []interface{}:
+		for i := range v {
+			v[i] = toInt64(v[i])
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,247 @@
+package ijson
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func gzipped(s string) []byte {
+	var b bytes.Buffer
+
+	w := gzip.NewWriter(&b)
+	_, _ = w.Write([]byte(s))
+	_ = w.Close()
+
+	return b.Bytes()
+}
+
+func TestParseReader(t *testing.T) {
+	type args struct {
+		data []byte
+		opts ParseOptions
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr error
+	}{
+		{
+			name: "no limits",
+			args: args{data: []byte(`{"id":[1,[2]]}`)},
+			want: map[string]interface{}{"id": []interface{}{1.0, []interface{}{2.0}}},
+		},
+		{
+			name: "within limits",
+			args: args{data: []byte(`{"id":[1,[2]]}`), opts: ParseOptions{MaxBytes: 14, MaxDepth: 3}},
+			want: map[string]interface{}{"id": []interface{}{1.0, []interface{}{2.0}}},
+		},
+		{
+			name:    "too large",
+			args:    args{data: []byte(`{"id":[1,[2]]}`), opts: ParseOptions{MaxBytes: 13}},
+			wantErr: ErrTooLarge,
+		},
+		{
+			name:    "too deep",
+			args:    args{data: []byte(`{"id":[1,[2]]}`), opts: ParseOptions{MaxDepth: 2}},
+			wantErr: ErrTooDeep,
+		},
+		{
+			name: "brackets in strings",
+			args: args{data: []byte(`["[[\"{{", "\\"]`), opts: ParseOptions{MaxDepth: 1}},
+			want: []interface{}{`[["{{`, `\`},
+		},
+		{
+			name: "gzip",
+			args: args{data: gzipped(`{"id":1}`), opts: ParseOptions{Gzip: true}},
+			want: map[string]interface{}{"id": 1.0},
+		},
+		{
+			name: "gzip/ plain payload",
+			args: args{data: []byte(`{"id":1}`), opts: ParseOptions{Gzip: true}},
+			want: map[string]interface{}{"id": 1.0},
+		},
+		{
+			name:    "gzip/ limit after decompression",
+			args:    args{data: gzipped(`"` + strings.Repeat("a", 1000) + `"`), opts: ParseOptions{Gzip: true, MaxBytes: 100}},
+			wantErr: ErrTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseReader(bytes.NewReader(tt.args.data), tt.args.opts)
+			if got.Error() != tt.wantErr {
+				t.Errorf("ParseReader() error = %v, wantErr %v", got.Error(), tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got.Value(), tt.want) {
+				t.Errorf("ParseReader() = %v, want %v", got.Value(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseReader_invalid(t *testing.T) {
+	if got := ParseReader(strings.NewReader(`{"id":`), ParseOptions{}); got.Error() == nil {
+		t.Errorf("ParseReader() error = nil, want syntax error")
+	}
+
+	if got := ParseReader(bytes.NewReader(gzipMagic), ParseOptions{Gzip: true}); got.Error() == nil {
+		t.Errorf("ParseReader() error = nil, want gzip error")
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "ijson")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+
+	_, _ = f.Write(gzipped(`{"id":"0"}`))
+	_ = f.Close()
+
+	got := ParseFile(f.Name(), ParseOptions{Gzip: true, MaxDepth: 1})
+	want := Result{val: map[string]interface{}{"id": "0"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseFile() = %v, want %v", got, want)
+	}
+
+	if got := ParseFile(f.Name()+".missing", ParseOptions{}); got.Error() == nil {
+		t.Errorf("ParseFile() error = nil, want error for missing file")
+	}
+}
+
+func TestParseReader_numbers(t *testing.T) {
+	const payload = `{"id":9007199254740993,"ratio":0.5,"big":1e400,"huge":18446744073709551616}`
+
+	type args struct {
+		mode NumberMode
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "float64",
+			args:    args{mode: Number_Float64},
+			want:    nil,
+			wantErr: true, // 1e400 overflows float64
+		},
+		{
+			name: "json.Number",
+			args: args{mode: Number_JSON},
+			want: map[string]interface{}{
+				"id":    json.Number("9007199254740993"),
+				"ratio": json.Number("0.5"),
+				"big":   json.Number("1e400"),
+				"huge":  json.Number("18446744073709551616"),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseReader(strings.NewReader(payload), ParseOptions{Numbers: tt.args.mode})
+			if (got.Error() != nil) != tt.wantErr {
+				t.Errorf("ParseReader() error = %v, wantErr %v", got.Error(), tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Value(), tt.want) {
+				t.Errorf("ParseReader() = %#v, want %#v", got.Value(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseReader_int64(t *testing.T) {
+	const payload = `{"id":9007199254740993,"ratio":0.5,"list":[1,-2,3.0,18446744073709551616]}`
+
+	got := ParseReader(strings.NewReader(payload), ParseOptions{Numbers: Number_Int64})
+	want := map[string]interface{}{
+		"id":    int64(9007199254740993),
+		"ratio": 0.5,
+		"list":  []interface{}{int64(1), int64(-2), 3.0, 18446744073709551616.0},
+	}
+	if !reflect.DeepEqual(got.Value(), want) {
+		t.Errorf("ParseReader() = %#v, want %#v", got.Value(), want)
+	}
+
+	if max := got.GetP("list.@max"); !reflect.DeepEqual(max.Value(), 18446744073709551616.0) {
+		t.Errorf("ParseReader() max = %v, want %v", max.Value(), 18446744073709551616.0)
+	}
+}
+
+func TestParseReader_fidelity(t *testing.T) {
+	const payload = `[{"id":9007199254740993,"name":"a"},{"id":9007199254740992,"name":"b"},{"id":1.50,"name":"c"}]`
+
+	for _, mode := range []NumberMode{Number_JSON, Number_Int64} {
+		r := ParseReader(strings.NewReader(payload), ParseOptions{Numbers: mode})
+
+		pred, _ := Cond(`id==9007199254740993`)
+		got, n, err := DelWhere(Clone(r.Value()), "", pred)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		names, _ := Get(got, "#~name")
+		if n != 1 || !reflect.DeepEqual(names, []interface{}{"b", "c"}) {
+			t.Errorf("DelWhere() mode %d = %v, removed %d", mode, names, n)
+		}
+
+		sorted, _ := Get(r.Value(), "@sort:-id", "#~name")
+		if !reflect.DeepEqual(sorted, []interface{}{"a", "b", "c"}) {
+			t.Errorf("Get(@sort) mode %d = %v", mode, sorted)
+		}
+
+		out, err := json.Marshal(r.Value())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := payload
+		if mode == Number_Int64 {
+			want = strings.Replace(payload, "1.50", "1.5", 1)
+		}
+
+		if string(out) != want {
+			t.Errorf("json.Marshal() mode %d = %s, want %s", mode, out, want)
+		}
+	}
+}
+
+func TestParseReader_trailing(t *testing.T) {
+	for _, mode := range []NumberMode{Number_Float64, Number_JSON, Number_Int64} {
+		if got := ParseReader(strings.NewReader(`{"id":1} {}`), ParseOptions{Numbers: mode}); got.Error() == nil {
+			t.Errorf("ParseReader() mode %d error = nil, want error for trailing data", mode)
+		}
+	}
+}
+
+func TestParseBytesWithOptions(t *testing.T) {
+	got := ParseBytesWithOptions([]byte(`[9007199254740993,1]`), ParseOptions{Numbers: Number_JSON})
+	if sum := got.Get("@sum").Value(); sum != int64(9007199254740994) {
+		t.Errorf("ParseBytesWithOptions() @sum = %v, want %v", sum, int64(9007199254740994))
+	}
+
+	got = ParseBytesWithOptions(gzipped(`{"id":[[1]]}`), ParseOptions{Gzip: true, MaxDepth: 2})
+	if got.Error() != ErrTooDeep {
+		t.Errorf("ParseBytesWithOptions() error = %v, want %v", got.Error(), ErrTooDeep)
+	}
+
+	got = ParseBytesWithOptions([]byte(`[1,2]`), ParseOptions{MaxBytes: 4})
+	if got.Error() != ErrTooLarge {
+		t.Errorf("ParseBytesWithOptions() error = %v, want %v", got.Error(), ErrTooLarge)
+	}
+}
@@ -33,6 +33,11 @@ const (
 	PDel_ArrIdx   // Delete from Array - "#<index>"
 	PDel_ArrIdxPO // Delete from Array - "#~<index>"
 	PDel_ArrEnd   // Delete from Array - "#"
+
+	// Paths added later are appended, so that the values of existing paths do not change.
+
+	PGet_Mod    // GET with Modifier - "@<name>" OR "@<name>:<arg>"
+	PSet_ArrIns // Insert in Array - "#+<index>"
 )
 
 const (
@@ -40,11 +45,24 @@ const (
 	PathArrayStart byte = 35
 	// PathWildCard is a byte representation of "~"
 	PathWildCard byte = 126
+	// PathModifier is a byte representation of "@"
+	PathModifier byte = 64
+	// PathInsert is a byte representation of "+"
+	PathInsert byte = 43
 )
 
 const (
 	PathArrayStartStr string = "#"
 	PathWildCardStr   string = "#~"
+	PathModifierStr   string = "@"
+	PathInsertStr     string = "#+"
+
+	// PathModifierEscStr escapes "@" at the start of an object key in Get paths, i.e. "@@type" gets the "@type" field.
+	PathModifierEscStr string = "@@"
+
+	// PathArgSepStr separates the elements of a key path in modifier arguments, i.e. "@sort:name/first".
+	// "." can not be used in `"."` separated paths, as it ends the modifier.
+	PathArgSepStr string = "/"
 )
 
 func DetectGetPath(p string) Path {
@@ -65,6 +83,11 @@ func DetectGetPath(p string) Path {
 
 		return PGet_ArrIdx
 	}
+
+	if p[0] == PathModifier && !strings.HasPrefix(p, PathModifierEscStr) {
+		return PGet_Mod
+	}
+
 	return PGet_Obj
 }
 
@@ -80,6 +103,10 @@ func DetectSetPath(p string) Path {
 			return PSet_ArrAppend
 		}
 
+		if p[1] == PathInsert {
+			return PSet_ArrIns
+		}
+
 		return PSet_ArrIdx
 	}
 
@@ -128,7 +155,7 @@ func index(p string, t Path) (int, error) {
 	switch t {
 	case PGet_ArrIdx, PSet_ArrIdx, PDel_ArrIdx:
 		i = p[1:]
-	case PDel_ArrIdxPO:
+	case PDel_ArrIdxPO, PSet_ArrIns:
 		i = p[2:]
 		// default:
 		// 	return 0, errors.New("unknown path to resolve index")
@@ -137,6 +164,15 @@ func index(p string, t Path) (int, error) {
 	return strconv.Atoi(i)
 }
 
+// objKey returns the object key for Get path element p, removing the escape of a leading "@".
+func objKey(p string) string {
+	if strings.HasPrefix(p, PathModifierEscStr) {
+		return p[1:]
+	}
+
+	return p
+}
+
 func field(p string) string {
 	// we have already resolved the path type and it is valid.
 	return p[2:]
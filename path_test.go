@@ -35,6 +35,16 @@ func TestDetectPath(t *testing.T) {
 			args: args{a: Act_Get, p: "#~name"},
 			want: PGet_ArrFld,
 		},
+		{
+			name: "Get modifier",
+			args: args{a: Act_Get, p: "@sum"},
+			want: PGet_Mod,
+		},
+		{
+			name: "Get escaped modifier",
+			args: args{a: Act_Get, p: "@@type"},
+			want: PGet_Obj,
+		},
 		{
 			name: "Get unknown path",
 			args: args{a: Act_Get, p: ""},
@@ -60,6 +70,11 @@ func TestDetectPath(t *testing.T) {
 			args: args{a: Act_Set, p: "#"},
 			want: PSet_ArrAppend,
 		},
+		{
+			name: "Set array insert",
+			args: args{a: Act_Set, p: "#+1"},
+			want: PSet_ArrIns,
+		},
 		{
 			name: "Set unknown path",
 			args: args{a: Act_Set, p: ""},
@@ -147,6 +162,12 @@ func Test_index(t *testing.T) {
 			want:    10,
 			wantErr: false,
 		},
+		{
+			name:    "set array insert",
+			args:    args{p: "#+10", t: PSet_ArrIns},
+			want:    10,
+			wantErr: false,
+		},
 		{
 			name:    "del array index with order preserved",
 			args:    args{p: "#~10", t: PDel_ArrIdxPO},
@@ -167,3 +188,19 @@ func Test_index(t *testing.T) {
 		})
 	}
 }
+
+func TestPath_values(t *testing.T) {
+	// values of the exported paths must not change, they may be persisted by users
+	paths := []Path{
+		P_Unknown, PGet_Obj, PGet_ArrLen, PGet_ArrIdx, PGet_ArrFld,
+		PSet_Obj, PSet_ArrIdx, PSet_ArrAppend,
+		PDel_Obj, PDel_ArrIdx, PDel_ArrIdxPO, PDel_ArrEnd,
+		PGet_Mod, PSet_ArrIns,
+	}
+
+	for i, p := range paths {
+		if p != Path(i) {
+			t.Errorf("path at %d has value %d", i, p)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package ijson
+
+// Pick returns a new data containing only the values at the provided `"."` separated paths.
+// Paths follow Get() syntax, "<key>", "#<index>" and "#~<key>". A path passing through "#~<key>" is applied to
+// every element of the array, i.e. "friends.#~id" keeps only "id" of all the elements of "friends".
+// Array elements not matching any path are dropped, the remaining elements keep their order.
+// Missing paths are ignored, an empty object or array is returned if none of the paths matches.
+// The input data is never modified, picked values are copied with Clone().
+// An error is returned for an invalid path.
+func Pick(data interface{}, paths ...string) (interface{}, error) {
+	m, err := newMask(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := m.pick(data); ok {
+		return v, nil
+	}
+
+	// nothing matched, an empty object or array is returned instead of a typed nil
+	switch data.(type) {
+	case map[string]interface{}:
+		return map[string]interface{}{}, nil
+	case []interface{}:
+		return []interface{}{}, nil
+	}
+
+	return nil, nil
+}
+
+// Omit returns a copy of the data without the values at the provided `"."` separated paths.
+// Paths follow the same syntax as Pick(). Missing paths are ignored. The input data is never modified.
+// An error is returned for an invalid path.
+func Omit(data interface{}, paths ...string) (interface{}, error) {
+	m, err := newMask(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.omit(Clone(data)), nil
+}
+
+// mask is a tree of the paths to pick or omit.
+type mask struct {
+	all    bool             // path ends here, the whole value is selected
+	fields map[string]*mask // object fields
+	idx    map[int]*mask    // array elements at index
+	each   *mask            // every array element
+}
+
+func newMask(paths []string) (*mask, error) {
+	root := &mask{}
+
+	for _, p := range paths {
+		cur := root
+		for _, e := range split(p) {
+			next, err := cur.child(e)
+			if err != nil {
+				return nil, err
+			}
+
+			cur = next
+		}
+
+		cur.all = true
+	}
+
+	return root, nil
+}
+
+// child returns the mask for path element e, creating it if required.
+func (m *mask) child(e string) (*mask, error) {
+	switch pathType := DetectGetPath(e); pathType {
+	case PGet_Obj:
+		if m.fields == nil {
+			m.fields = make(map[string]*mask)
+		}
+
+		k := objKey(e)
+		if m.fields[k] == nil {
+			m.fields[k] = &mask{}
+		}
+
+		return m.fields[k], nil
+
+	case PGet_ArrIdx:
+		i, err := index(e, pathType)
+		if err != nil || i < 0 {
+			return nil, errInvPth
+		}
+
+		if m.idx == nil {
+			m.idx = make(map[int]*mask)
+		}
+
+		if m.idx[i] == nil {
+			m.idx[i] = &mask{}
+		}
+
+		return m.idx[i], nil
+
+	case PGet_ArrFld:
+		if m.each == nil {
+			m.each = &mask{}
+		}
+
+		return m.each.child(field(e))
+
+	default:
+		return nil, errInvPth
+	}
+}
+
+// elem returns the combined mask for array element at i, nil if the element is not selected.
+func (m *mask) elem(i int) *mask {
+	return union(m.each, m.idx[i])
+}
+
+func union(a, b *mask) *mask {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	u := &mask{all: a.all || b.all, each: union(a.each, b.each)}
+
+	if len(a.fields)+len(b.fields) > 0 {
+		u.fields = make(map[string]*mask, len(a.fields)+len(b.fields))
+		for k, v := range a.fields {
+			u.fields[k] = v
+		}
+
+		for k, v := range b.fields {
+			u.fields[k] = union(u.fields[k], v)
+		}
+	}
+
+	if len(a.idx)+len(b.idx) > 0 {
+		u.idx = make(map[int]*mask, len(a.idx)+len(b.idx))
+		for k, v := range a.idx {
+			u.idx[k] = v
+		}
+
+		for k, v := range b.idx {
+			u.idx[k] = union(u.idx[k], v)
+		}
+	}
+
+	return u
+}
+
+// pick returns the selected part of data, ok is false if nothing is selected.
+func (m *mask) pick(data interface{}) (interface{}, bool) {
+	if m.all {
+		return Clone(data), true
+	}
+
+	switch v := data.(type) {
+	case map[string]interface{}:
+		var result map[string]interface{}
+		for k, sub := range m.fields {
+			e, exists := v[k]
+			if !exists {
+				continue
+			}
+
+			if p, ok := sub.pick(e); ok {
+				if result == nil {
+					result = make(map[string]interface{}, len(m.fields))
+				}
+
+				result[k] = p
+			}
+		}
+
+		return result, result != nil
+
+	case []interface{}:
+		var result []interface{}
+		for i := range v {
+			sub := m.elem(i)
+			if sub == nil {
+				continue
+			}
+
+			if p, ok := sub.pick(v[i]); ok {
+				result = append(result, p)
+			}
+		}
+
+		return result, result != nil
+	}
+
+	return nil, false
+}
+
+// omit removes the selected parts from data in place.
+func (m *mask) omit(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for k, sub := range m.fields {
+			e, exists := v[k]
+			if !exists {
+				continue
+			}
+
+			if sub.all {
+				delete(v, k)
+				continue
+			}
+
+			v[k] = sub.omit(e)
+		}
+
+		return v
+
+	case []interface{}:
+		j := 0
+		for i := range v {
+			sub := m.elem(i)
+			if sub != nil && sub.all {
+				continue
+			}
+
+			if sub != nil {
+				v[i] = sub.omit(v[i])
+			}
+
+			v[j] = v[i]
+			j++
+		}
+
+		return v[:j]
+	}
+
+	return data
+}
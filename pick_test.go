@@ -0,0 +1,153 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPick(t *testing.T) {
+	type args struct {
+		data  interface{}
+		paths []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "fields",
+			args: args{data: payload(), paths: []string{"name", "address.city"}},
+			want: map[string]interface{}{
+				"name":    "tom",
+				"address": map[string]interface{}{"city": "pune"},
+			},
+		},
+		{
+			name: "through arrays",
+			args: args{data: Nested(), paths: []string{"#~index", "#~friends.#~id"}},
+			want: []interface{}{
+				map[string]interface{}{
+					"index":   0,
+					"friends": []interface{}{map[string]interface{}{"id": 0}, map[string]interface{}{"id": 0}, map[string]interface{}{"id": 1}},
+				},
+			},
+		},
+		{
+			name: "array index",
+			args: args{data: Array(), paths: []string{"#2.name", "#0.id"}},
+			want: []interface{}{map[string]interface{}{"id": 0}, map[string]interface{}{"name": "Marianne Rutledge"}},
+		},
+		{
+			name: "missing paths",
+			args: args{data: payload(), paths: []string{"name", "age", "address.zip", "friends.#5"}},
+			want: map[string]interface{}{"name": "tom"},
+		},
+		{
+			name: "escaped field starting with @",
+			args: args{data: map[string]interface{}{"@id": "a1", "name": "tom"}, paths: []string{"@@id"}},
+			want: map[string]interface{}{"@id": "a1"},
+		},
+		{
+			name: "nothing matched",
+			args: args{data: payload(), paths: []string{"age"}},
+			want: map[string]interface{}{},
+		},
+		{
+			name: "nothing matched/ array",
+			args: args{data: Array(), paths: []string{"#~age"}},
+			want: []interface{}{},
+		},
+		{
+			name: "nothing matched/ scalar",
+			args: args{data: "tom", paths: []string{"name"}},
+			want: nil,
+		},
+		{
+			name:    "invalid path",
+			args:    args{data: payload(), paths: []string{"friends.#"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Pick(tt.args.data, tt.args.paths...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Pick() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pick() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPick_copy(t *testing.T) {
+	data := payload()
+
+	got, _ := Pick(data, "address")
+	got.(map[string]interface{})["address"].(map[string]interface{})["city"] = "mumbai"
+
+	if !reflect.DeepEqual(data, payload()) {
+		t.Errorf("Pick() shares the data with input = %v", data)
+	}
+}
+
+func TestOmit(t *testing.T) {
+	type args struct {
+		data  interface{}
+		paths []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "fields",
+			args: args{data: payload(), paths: []string{"name", "address.city", "age"}},
+			want: map[string]interface{}{
+				"address": map[string]interface{}{},
+				"friends": []interface{}{"jerry", "spike"},
+			},
+		},
+		{
+			name: "through arrays",
+			args: args{data: Array(), paths: []string{"#~name"}},
+			want: []interface{}{map[string]interface{}{"id": 0}, map[string]interface{}{"id": 0}, map[string]interface{}{"id": 1}},
+		},
+		{
+			name: "array indexes keep order",
+			args: args{data: []interface{}{0, 1, 2, 3}, paths: []string{"#0", "#2"}},
+			want: []interface{}{1, 3},
+		},
+		{
+			name:    "invalid path",
+			args:    args{data: payload(), paths: []string{"@count"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.args.data
+			before := Clone(data)
+
+			got, err := Omit(data, tt.args.paths...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Omit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Omit() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(data, before) {
+				t.Errorf("Omit() modified the input = %v", data)
+			}
+		})
+	}
+}
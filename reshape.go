@@ -0,0 +1,140 @@
+package ijson
+
+import (
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+func modFlatten(data interface{}, arg string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	depth := 1
+	if arg != "" {
+		d, err := strconv.Atoi(arg)
+		if err != nil || d < 0 {
+			return nil, errInvArg
+		}
+
+		depth = d
+	}
+
+	return flatten(make([]interface{}, 0, len(array)), array, depth), nil
+}
+
+func modReverse(data interface{}, _ string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	result := make([]interface{}, len(array))
+	for i := range array {
+		result[len(array)-1-i] = array[i]
+	}
+
+	return result, nil
+}
+
+func modFirst(data interface{}, _ string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	if len(array) == 0 {
+		return nil, errEmpArr
+	}
+
+	return array[0], nil
+}
+
+func modLast(data interface{}, _ string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	if len(array) == 0 {
+		return nil, errEmpArr
+	}
+
+	return array[len(array)-1], nil
+}
+
+func modKeys(data interface{}, _ string) (interface{}, error) {
+	object, valid := data.(map[string]interface{})
+	if !valid {
+		return nil, errExpObj
+	}
+
+	keys := sortedKeys(object)
+
+	result := make([]interface{}, len(keys))
+	for i := range keys {
+		result[i] = keys[i]
+	}
+
+	return result, nil
+}
+
+func modValues(data interface{}, _ string) (interface{}, error) {
+	object, valid := data.(map[string]interface{})
+	if !valid {
+		return nil, errExpObj
+	}
+
+	keys := sortedKeys(object)
+
+	result := make([]interface{}, len(keys))
+	for i := range keys {
+		result[i] = object[keys[i]]
+	}
+
+	return result, nil
+}
+
+func modLen(data interface{}, _ string) (interface{}, error) {
+	switch v := data.(type) {
+	case nil:
+		return 0, nil
+	case []interface{}:
+		return len(v), nil
+	case map[string]interface{}:
+		return len(v), nil
+	case string:
+		return utf8.RuneCountInString(v), nil
+	default:
+		return nil, errExpCnt
+	}
+}
+
+// flatten appends the elements of array to dst, unwrapping the nested arrays up to depth levels.
+func flatten(dst, array []interface{}, depth int) []interface{} {
+	for i := range array {
+		nested, ok := array[i].([]interface{})
+		if !ok || depth == 0 {
+			dst = append(dst, array[i])
+			continue
+		}
+
+		dst = flatten(dst, nested, depth-1)
+	}
+
+	return dst
+}
+
+// sortedKeys returns the keys of the object in ascending order.
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for k := range object {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
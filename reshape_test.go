@@ -0,0 +1,124 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGet_Reshape(t *testing.T) {
+	nested := []interface{}{1, []interface{}{2, []interface{}{3, []interface{}{4}}}}
+
+	type args struct {
+		data interface{}
+		path []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "flatten/ default depth",
+			args:    args{data: nested, path: []string{"@flatten"}},
+			want:    []interface{}{1, 2, []interface{}{3, []interface{}{4}}},
+			wantErr: false,
+		},
+		{
+			name:    "flatten/ with depth",
+			args:    args{data: nested, path: []string{"@flatten:5"}},
+			want:    []interface{}{1, 2, 3, 4},
+			wantErr: false,
+		},
+		{
+			name:    "flatten/ invalid depth",
+			args:    args{data: nested, path: []string{"@flatten:a"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "flatten/ plucked arrays",
+			args:    args{data: Nested(), path: []string{"#~friends", "@flatten", "#~id"}},
+			want:    []interface{}{0, 0, 1},
+			wantErr: false,
+		},
+		{
+			name:    "reverse",
+			args:    args{data: []interface{}{1, 2, 3}, path: []string{"@reverse"}},
+			want:    []interface{}{3, 2, 1},
+			wantErr: false,
+		},
+		{
+			name:    "reverse/ object",
+			args:    args{data: Object(), path: []string{"@reverse"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "first",
+			args:    args{data: Array(), path: []string{"@first", "name"}},
+			want:    "Justine Bird",
+			wantErr: false,
+		},
+		{
+			name:    "last",
+			args:    args{data: Array(), path: []string{"@last", "name"}},
+			want:    "Marianne Rutledge",
+			wantErr: false,
+		},
+		{
+			name:    "last/ empty array",
+			args:    args{data: []interface{}{}, path: []string{"@last"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "keys",
+			args:    args{data: Object(), path: []string{"@keys"}},
+			want:    []interface{}{"id", "name"},
+			wantErr: false,
+		},
+		{
+			name:    "values",
+			args:    args{data: Object(), path: []string{"@values"}},
+			want:    []interface{}{0, "Justine Bird"},
+			wantErr: false,
+		},
+		{
+			name:    "values/ array",
+			args:    args{data: Array(), path: []string{"@values"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "len/ object",
+			args:    args{data: Object(), path: []string{"@len"}},
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name:    "len/ string in runes",
+			args:    args{data: "héllo", path: []string{"@len"}},
+			want:    5,
+			wantErr: false,
+		},
+		{
+			name:    "len/ number",
+			args:    args{data: 1, path: []string{"@len"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(tt.args.data, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
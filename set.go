@@ -1,27 +1,79 @@
 package ijson
 
+import (
+	"fmt"
+	"strings"
+)
+
+// SetOptions controls the behaviour of SetWithOptions().
+// The zero value behaves same as Set().
+type SetOptions struct {
+	Force     bool               // replace the structure if it is not same as expected by the path, same as SetF()
+	MaxGrowth int                // maximum number of elements an array can grow by, 2^20 OR length of the array whichever is larger if zero
+	Fill      interface{}        // value for the padded elements when an array is extended beyond its length
+	FillFunc  func() interface{} // returns value for each padded element, takes precedence over Fill
+	NoCreate  bool               // do not create the missing objects and arrays on the path
+	NoAppend  bool               // do not allow appending to arrays with "#"
+}
+
+// defaultGrowth is the number of elements an array can grow by in a single Set(), if SetOptions.MaxGrowth is not set.
+// Larger arrays can grow by their length, so a large index can not exhaust the memory.
+const defaultGrowth = 1 << 20
+
+// setOpts controls the behaviour of set().
+type setOpts struct {
+	SetOptions
+
+	clone bool // copy the objects and arrays on the path instead of modifying them in place
+
+	update UpdateFunc // computes the value at the end of the path, value is set as it is if nil
+	root   []string   // complete path, to report errors
+	keys   []bool     // keys[i] is set if path[i] is an object key whatever its syntax, used by Unflatten()
+}
+
+// OptionError is returned by SetWithOptions() if the path violates any of the SetOptions.
+type OptionError struct {
+	Option string   // name of the violated option
+	Path   []string // path of the object or array that violates the option
+}
+
+func (e OptionError) Error() string {
+	return fmt.Sprintf("path %q violates %s option", strings.Join(e.Path, "."), e.Option)
+}
+
 // Set sets the provide value to the path. It creates the structure if not present.
 // An error is returned if it fails to resolve the path OR encounters different type than expected by path.
 func Set(data, value interface{}, path ...string) (interface{}, error) {
-	return set(data, value, false, path...)
+	return set(data, value, setOpts{}, path...)
 }
 
 // SetP is same as Set(). It just takes `"."` separated path.
 func SetP(data, value interface{}, path string) (interface{}, error) {
-	return setP(data, value, false, path)
+	return setP(data, value, setOpts{}, path)
 }
 
 // SetF is same as Set(). It just forcefully replaces the structure if it is not same as expected by the path.
 func SetF(data, value interface{}, path ...string) (interface{}, error) {
-	return set(data, value, true, path...)
+	return set(data, value, setOpts{SetOptions: SetOptions{Force: true}}, path...)
 }
 
 // SetFP is same as SetF(). It just takes `"."` separated path.
 func SetFP(data, value interface{}, path string) (interface{}, error) {
-	return setP(data, value, true, path)
+	return setP(data, value, setOpts{SetOptions: SetOptions{Force: true}}, path)
+}
+
+// SetWithOptions is same as Set(). It just limits the changes to the structure as per opts.
+// An OptionError is returned if the path violates any of the options.
+func SetWithOptions(data, value interface{}, opts SetOptions, path ...string) (interface{}, error) {
+	return set(data, value, setOpts{SetOptions: opts, root: path}, path...)
 }
 
-func set(data interface{}, value interface{}, force bool, path ...string) (interface{}, error) {
+// SetWithOptionsP is same as SetWithOptions(). It just takes `"."` separated path.
+func SetWithOptionsP(data, value interface{}, opts SetOptions, path string) (interface{}, error) {
+	return SetWithOptions(data, value, opts, split(path)...)
+}
+
+func set(data interface{}, value interface{}, o setOpts, path ...string) (interface{}, error) {
 	if len(path) == 0 {
 		if data == nil {
 			return value, nil
@@ -31,21 +83,46 @@ func set(data interface{}, value interface{}, force bool, path ...string) (inter
 	}
 
 	pathType := DetectSetPath(path[0])
+	if len(o.keys) > 0 {
+		if o.keys[0] {
+			pathType = PSet_Obj
+		}
+
+		o.keys = o.keys[1:]
+	}
+
 	switch pathType {
 	case PSet_Obj:
 
 		object, valid := data.(map[string]interface{})
 		if !valid {
-			if data == nil || force {
+			if data == nil || o.Force {
+				if o.NoCreate {
+					return nil, o.violation("NoCreate", path)
+				}
+
 				object = make(map[string]interface{}, 1)
 			} else {
 				return nil, errExpObj
 			}
 
 			// object = make(map[string]interface{})
+		} else if o.clone {
+			object = cloneObject(object)
+		}
+
+		old, exists := object[path[0]]
+
+		newData, err := setElem(old, exists, value, o, path[1:]...)
+		if err == ErrDelete {
+			if !exists {
+				return nil, errNoChng
+			}
+
+			delete(object, path[0])
+			return object, nil
 		}
 
-		newData, err := set(object[path[0]], value, force, path[1:]...)
 		if err != nil {
 			return nil, err
 		}
@@ -61,18 +138,53 @@ func set(data interface{}, value interface{}, force bool, path ...string) (inter
 			return nil, err
 		}
 
+		if idx < 0 {
+			return nil, errOutBnd
+		}
+
 		array, valid := data.([]interface{})
 		if !valid {
-			if data == nil || force {
-				array = make([]interface{}, idx+1)
+			if data == nil || o.Force {
+				if o.NoCreate {
+					return nil, o.violation("NoCreate", path)
+				}
+
+				array = nil
 			} else {
 				return nil, errExpArr
 			}
+		}
+
+		l := len(array)
+		if o.MaxGrowth > 0 && idx-l >= o.MaxGrowth {
+			return nil, o.violation("MaxGrowth", path)
+		}
+
+		if o.MaxGrowth <= 0 && idx-l >= defaultGrowth && idx-l >= l {
+			return nil, errOutBnd
+		}
+
+		if !valid {
+			array = make([]interface{}, idx+1)
 		} else {
+			if o.clone {
+				array = cloneArray(array)
+			}
+
 			array = extend(array, idx)
 		}
 
-		newData, err := set(array[idx], value, force, path[1:]...)
+		o.fill(array, l, idx)
+
+		newData, err := setElem(array[idx], idx < l, value, o, path[1:]...)
+		if err == ErrDelete {
+			if idx < l {
+				return DeleteAtIndexPO(array[:l], idx)
+			}
+
+			return nil, errNoChng
+		}
+
 		if err != nil {
 			return nil, err
 		}
@@ -82,26 +194,120 @@ func set(data interface{}, value interface{}, force bool, path ...string) (inter
 		return array, nil
 
 	case PSet_ArrAppend:
+		if o.NoAppend {
+			return nil, o.violation("NoAppend", path)
+		}
+
 		array, valid := data.([]interface{})
 		if !valid {
-			if data == nil || force {
+			if data == nil || o.Force {
+				if o.NoCreate {
+					return nil, o.violation("NoCreate", path)
+				}
+
 				array = make([]interface{}, 0, 1)
 			} else {
 				return nil, errExpArr
 			}
+		} else if o.clone {
+			// limit the capacity so that append never writes to the shared backing array
+			array = array[:len(array):len(array)]
 		}
 
-		array = append(array, value)
+		newData, err := setElem(nil, false, value, o)
+		if err == ErrDelete {
+			return nil, errNoChng
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		array = append(array, newData)
 
 		return array, nil
 
+	case PSet_ArrIns:
+		idx, err := index(path[0], PSet_ArrIns)
+		if err != nil {
+			return nil, err
+		}
+
+		array, valid := data.([]interface{})
+		if !valid {
+			if data == nil || o.Force {
+				if o.NoCreate {
+					return nil, o.violation("NoCreate", path)
+				}
+
+				array = nil
+			} else {
+				return nil, errExpArr
+			}
+		}
+
+		if idx < 0 || idx > len(array) {
+			return nil, errOutBnd
+		}
+
+		newData, err := setElem(nil, false, value, o, path[1:]...)
+		if err == ErrDelete {
+			return nil, errNoChng
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return insert(array, idx, newData, o.clone), nil
+
 	default:
 		return nil, errInvPth
 	}
 }
 
-func setP(data interface{}, value interface{}, force bool, path string) (interface{}, error) {
-	return set(data, value, force, split(path)...)
+// setElem sets the value in the element of an object or array.
+// Unlike set(), the existing element is replaced by the value at the end of the path.
+// exists tells whether the element is present in its parent.
+func setElem(elem interface{}, exists bool, value interface{}, o setOpts, path ...string) (interface{}, error) {
+	if len(path) == 0 {
+		if o.update != nil {
+			return o.update(elem, exists)
+		}
+
+		return value, nil
+	}
+
+	return set(elem, value, o, path...)
+}
+
+// fill sets the fill value for padded elements in [from, to).
+func (o setOpts) fill(array []interface{}, from, to int) {
+	if o.FillFunc == nil && o.Fill == nil {
+		return
+	}
+
+	for i := from; i < to; i++ {
+		if o.FillFunc != nil {
+			array[i] = o.FillFunc()
+		} else {
+			array[i] = o.Fill
+		}
+	}
+}
+
+// violation returns an OptionError for the object or array on which the remaining path is to be set.
+func (o setOpts) violation(option string, path []string) error {
+	n := len(o.root) - len(path)
+	if n < 0 {
+		n = 0
+	}
+
+	return OptionError{Option: option, Path: o.root[:n]}
+}
+
+func setP(data interface{}, value interface{}, o setOpts, path string) (interface{}, error) {
+	return set(data, value, o, split(path)...)
 }
 
 func extend(arr []interface{}, idx int) []interface{} {
@@ -112,3 +318,40 @@ func extend(arr []interface{}, idx int) []interface{} {
 
 	return arr
 }
+
+// insert inserts the value at idx, shifting the later elements to right. idx must be within [0, len(arr)].
+// A new array is allocated if clone is set, otherwise the input array may be modified.
+func insert(arr []interface{}, idx int, value interface{}, clone bool) []interface{} {
+	if clone {
+		c := make([]interface{}, len(arr)+1)
+		copy(c, arr[:idx])
+		copy(c[idx+1:], arr[idx:])
+		c[idx] = value
+
+		return c
+	}
+
+	arr = append(arr, nil)
+	copy(arr[idx+1:], arr[idx:])
+	arr[idx] = value
+
+	return arr
+}
+
+// cloneObject returns a shallow copy of the object.
+func cloneObject(object map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(object)+1)
+	for k, v := range object {
+		c[k] = v
+	}
+
+	return c
+}
+
+// cloneArray returns a shallow copy of the array.
+func cloneArray(array []interface{}) []interface{} {
+	c := make([]interface{}, len(array))
+	copy(c, array)
+
+	return c
+}
@@ -107,6 +107,136 @@ func TestSet(t *testing.T) {
 			want:    []interface{}{1},
 			wantErr: false,
 		},
+		{
+			name: "array/ insert",
+			args: args{
+				data:  []interface{}{1, 2, 3},
+				value: 4,
+				path:  []string{"#+1"},
+			},
+			want:    []interface{}{1, 4, 2, 3},
+			wantErr: false,
+		},
+		{
+			name: "array/ prepend",
+			args: args{
+				data:  []interface{}{1, 2, 3},
+				value: 4,
+				path:  []string{"#+0"},
+			},
+			want:    []interface{}{4, 1, 2, 3},
+			wantErr: false,
+		},
+		{
+			name: "array/ insert at end",
+			args: args{
+				data:  []interface{}{1, 2, 3},
+				value: 4,
+				path:  []string{"#+3"},
+			},
+			want:    []interface{}{1, 2, 3, 4},
+			wantErr: false,
+		},
+		{
+			name: "array/ insert out of range",
+			args: args{
+				data:  []interface{}{1, 2, 3},
+				value: 4,
+				path:  []string{"#+4"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "array/ negative index",
+			args: args{
+				data:  []interface{}{1, 2, 3},
+				value: 4,
+				path:  []string{"#-1"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "array/ max int index",
+			args: args{
+				data:  []interface{}{1},
+				value: 4,
+				path:  []string{"#9223372036854775807"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "nil/ max int index",
+			args: args{
+				data:  nil,
+				value: 4,
+				path:  []string{"#9223372036854775807"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "nil/ index beyond default growth",
+			args: args{
+				data:  nil,
+				value: 4,
+				path:  []string{"#4000000000"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "array/ index within default growth",
+			args: args{
+				data:  []interface{}{1},
+				value: 4,
+				path:  []string{"#3"},
+			},
+			want:    []interface{}{1, nil, nil, 4},
+			wantErr: false,
+		},
+		{
+			name: "array/ insert negative index",
+			args: args{
+				data:  []interface{}{1, 2, 3},
+				value: 4,
+				path:  []string{"#+-1"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "array/ insert invalid index",
+			args: args{
+				data:  []interface{}{1, 2, 3},
+				value: 4,
+				path:  []string{"#+a"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "nil/ insert nested object",
+			args: args{
+				data:  nil,
+				value: "tom",
+				path:  []string{"#+0", "name"},
+			},
+			want:    []interface{}{map[string]interface{}{"name": "tom"}},
+			wantErr: false,
+		},
+		{
+			name: "object/ insert",
+			args: args{
+				data:  make(map[string]interface{}),
+				value: 1,
+				path:  []string{"#+0"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "object/ append",
 			args: args{
@@ -137,6 +267,26 @@ func TestSet(t *testing.T) {
 			want:    map[string]interface{}{"visits": 1, "name": "tom"},
 			wantErr: false,
 		},
+		{
+			name: "object/ existing field",
+			args: args{
+				data:  map[string]interface{}{"name": "tom"},
+				value: "jerry",
+				path:  []string{"name"},
+			},
+			want:    map[string]interface{}{"name": "jerry"},
+			wantErr: false,
+		},
+		{
+			name: "array/ existing index",
+			args: args{
+				data:  []interface{}{1, 2},
+				value: 3,
+				path:  []string{"#0"},
+			},
+			want:    []interface{}{3, 2},
+			wantErr: false,
+		},
 		{
 			name: "object/ valid path/ invalid nested array",
 			args: args{
@@ -253,3 +403,131 @@ func Test_extend(t *testing.T) {
 		})
 	}
 }
+
+func TestSetWithOptions(t *testing.T) {
+	type args struct {
+		data  interface{}
+		value interface{}
+		opts  SetOptions
+		path  []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr error
+	}{
+		{
+			name: "max growth/ within limit",
+			args: args{
+				data:  []interface{}{1},
+				value: 2,
+				opts:  SetOptions{MaxGrowth: 2},
+				path:  []string{"#2"},
+			},
+			want: []interface{}{1, nil, 2},
+		},
+		{
+			name: "max growth/ exceeded",
+			args: args{
+				data:  map[string]interface{}{"list": []interface{}{1}},
+				value: 2,
+				opts:  SetOptions{MaxGrowth: 2},
+				path:  []string{"list", "#1000000000"},
+			},
+			wantErr: OptionError{Option: "MaxGrowth", Path: []string{"list"}},
+		},
+		{
+			name: "max growth/ exceeded with max int index",
+			args: args{
+				data:  nil,
+				value: 2,
+				opts:  SetOptions{MaxGrowth: 10},
+				path:  []string{"#9223372036854775807"},
+			},
+			wantErr: OptionError{Option: "MaxGrowth", Path: []string{}},
+		},
+		{
+			name: "max growth/ exceeded on nil",
+			args: args{
+				data:  nil,
+				value: 2,
+				opts:  SetOptions{MaxGrowth: 1},
+				path:  []string{"#1"},
+			},
+			wantErr: OptionError{Option: "MaxGrowth", Path: []string{}},
+		},
+		{
+			name: "fill value",
+			args: args{
+				data:  []interface{}{1},
+				value: 2,
+				opts:  SetOptions{Fill: 0},
+				path:  []string{"#3"},
+			},
+			want: []interface{}{1, 0, 0, 2},
+		},
+		{
+			name: "fill func",
+			args: args{
+				data:  nil,
+				value: 2,
+				opts:  SetOptions{Fill: 0, FillFunc: func() interface{} { return map[string]interface{}{} }},
+				path:  []string{"#1"},
+			},
+			want: []interface{}{map[string]interface{}{}, 2},
+		},
+		{
+			name: "no create/ missing parent",
+			args: args{
+				data:  map[string]interface{}{"name": "tom"},
+				value: "pune",
+				opts:  SetOptions{NoCreate: true},
+				path:  []string{"address", "city"},
+			},
+			wantErr: OptionError{Option: "NoCreate", Path: []string{"address"}},
+		},
+		{
+			name: "no create/ existing parent",
+			args: args{
+				data:  map[string]interface{}{"address": map[string]interface{}{}},
+				value: "pune",
+				opts:  SetOptions{NoCreate: true},
+				path:  []string{"address", "city"},
+			},
+			want: map[string]interface{}{"address": map[string]interface{}{"city": "pune"}},
+		},
+		{
+			name: "no create/ forced replacement",
+			args: args{
+				data:  map[string]interface{}{"address": "pune"},
+				value: "pune",
+				opts:  SetOptions{NoCreate: true, Force: true},
+				path:  []string{"address", "#0"},
+			},
+			wantErr: OptionError{Option: "NoCreate", Path: []string{"address"}},
+		},
+		{
+			name: "no append",
+			args: args{
+				data:  map[string]interface{}{"tags": []interface{}{}},
+				value: "a",
+				opts:  SetOptions{NoAppend: true},
+				path:  []string{"tags", "#"},
+			},
+			wantErr: OptionError{Option: "NoAppend", Path: []string{"tags"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SetWithOptions(tt.args.data, tt.args.value, tt.args.opts, tt.args.path...)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("SetWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetWithOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
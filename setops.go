@@ -0,0 +1,70 @@
+package ijson
+
+// Union returns a new array of the elements present in a OR b, without duplicates.
+// Elements are in order of their first occurrence, elements of a come before elements of b.
+// Elements are compared by the values present at `"."` separated keyPaths, the element itself is compared
+// if no keyPath is provided. See Unique() for details about comparison.
+// An error is returned if any of the inputs is not an array.
+func Union(a, b interface{}, keyPaths ...string) ([]interface{}, error) {
+	x, y, err := arrays(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, 0, len(x)+len(y))
+	result = append(result, x...)
+	result = append(result, y...)
+
+	return unique(result, keyPaths...), nil
+}
+
+// Intersect returns a new array of the elements of a that are present in b, without duplicates.
+// Elements are in order of their first occurrence in a. See Union() for details about comparison.
+// An error is returned if any of the inputs is not an array.
+func Intersect(a, b interface{}, keyPaths ...string) ([]interface{}, error) {
+	return intersect(a, b, true, keyPaths)
+}
+
+// Difference returns a new array of the elements of a that are not present in b, without duplicates.
+// Elements are in order of their first occurrence in a. See Union() for details about comparison.
+// An error is returned if any of the inputs is not an array.
+func Difference(a, b interface{}, keyPaths ...string) ([]interface{}, error) {
+	return intersect(a, b, false, keyPaths)
+}
+
+// intersect returns the elements of a for which the presence in b is same as present.
+func intersect(a, b interface{}, present bool, keyPaths []string) ([]interface{}, error) {
+	x, y, err := arrays(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := newValueSet(len(y))
+	for i := range y {
+		keys.add(uniqueKey(y[i], keyPaths))
+	}
+
+	result := make([]interface{}, 0, len(x))
+	for i := range x {
+		if keys.has(uniqueKey(x[i], keyPaths)) == present {
+			result = append(result, x[i])
+		}
+	}
+
+	return unique(result, keyPaths...), nil
+}
+
+// arrays asserts both the inputs to be arrays.
+func arrays(a, b interface{}) ([]interface{}, []interface{}, error) {
+	x, valid := a.([]interface{})
+	if !valid {
+		return nil, nil, errExpArr
+	}
+
+	y, valid := b.([]interface{})
+	if !valid {
+		return nil, nil, errExpArr
+	}
+
+	return x, y, nil
+}
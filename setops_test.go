@@ -0,0 +1,194 @@
+package ijson
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func byID(ids ...int) []interface{} {
+	result := make([]interface{}, len(ids))
+	for i := range ids {
+		result[i] = map[string]interface{}{"id": ids[i], "seq": i}
+	}
+
+	return result
+}
+
+func TestUnion(t *testing.T) {
+	type args struct {
+		a, b     interface{}
+		keyPaths []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name:    "values",
+			args:    args{a: []interface{}{3, 1, 3}, b: []interface{}{2.0, 1.0, "1"}},
+			want:    []interface{}{3, 1, 2.0, "1"},
+			wantErr: false,
+		},
+		{
+			name:    "objects/ by key",
+			args:    args{a: byID(1, 2), b: byID(2, 3), keyPaths: []string{"id"}},
+			want:    []interface{}{byID(1, 2)[0], byID(1, 2)[1], byID(2, 3)[1]},
+			wantErr: false,
+		},
+		{
+			name:    "empty",
+			args:    args{a: []interface{}{}, b: []interface{}{}},
+			want:    []interface{}{},
+			wantErr: false,
+		},
+		{
+			name:    "not an array",
+			args:    args{a: []interface{}{}, b: Object()},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Union(tt.args.a, tt.args.b, tt.args.keyPaths...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Union() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	type args struct {
+		a, b     interface{}
+		keyPaths []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name:    "values",
+			args:    args{a: []interface{}{3, 1, 2, 1}, b: []interface{}{1.0, int64(3), 4}},
+			want:    []interface{}{3, 1},
+			wantErr: false,
+		},
+		{
+			name:    "objects/ deep equality",
+			args:    args{a: Array(), b: []interface{}{Object()}},
+			want:    []interface{}{Object()},
+			wantErr: false,
+		},
+		{
+			name:    "values/ strings and numbers",
+			args:    args{a: []interface{}{"1", 1, nil, "null"}, b: []interface{}{json.Number("1"), "null"}},
+			want:    []interface{}{1, "null"},
+			wantErr: false,
+		},
+		{
+			name:    "values/ other Go types",
+			args:    args{a: []interface{}{[]string{"a"}, []string{"b"}}, b: []interface{}{[]string{"b"}}},
+			want:    []interface{}{[]string{"b"}},
+			wantErr: false,
+		},
+		{
+			name:    "objects/ by key",
+			args:    args{a: byID(1, 2, 3), b: byID(3, 1), keyPaths: []string{"id"}},
+			want:    []interface{}{byID(1, 2, 3)[0], byID(1, 2, 3)[2]},
+			wantErr: false,
+		},
+		{
+			name:    "not an array",
+			args:    args{a: nil, b: []interface{}{}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Intersect(tt.args.a, tt.args.b, tt.args.keyPaths...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Intersect() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifference(t *testing.T) {
+	type args struct {
+		a, b     interface{}
+		keyPaths []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name:    "values",
+			args:    args{a: []interface{}{3, 1, 2, 2}, b: []interface{}{1.0}},
+			want:    []interface{}{3, 2},
+			wantErr: false,
+		},
+		{
+			name:    "objects/ by key",
+			args:    args{a: byID(1, 2, 3), b: byID(2), keyPaths: []string{"id"}},
+			want:    []interface{}{byID(1, 2, 3)[0], byID(1, 2, 3)[2]},
+			wantErr: false,
+		},
+		{
+			name:    "objects/ missing key is null",
+			args:    args{a: []interface{}{Object(), map[string]interface{}{}}, b: []interface{}{nil}, keyPaths: []string{"id"}},
+			want:    []interface{}{Object()},
+			wantErr: false,
+		},
+		{
+			name:    "not an array",
+			args:    args{a: Object(), b: []interface{}{}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Difference(tt.args.a, tt.args.b, tt.args.keyPaths...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Difference() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Difference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Difference(t *testing.T) {
+	src := New(map[string]interface{}{"records": byID(1, 2, 3)}).GetP("records")
+	dst := New(map[string]interface{}{"items": byID(3, 1)}).GetP("items")
+
+	got := src.Difference(dst, "id").GetP("#~id")
+
+	want := Result{val: []interface{}{2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Difference() = %v, want %v", got, want)
+	}
+
+	if got := src.Union(New(nil).GetP("missing")); got.Error() == nil {
+		t.Errorf("Result.Union() error = nil, want error from other")
+	}
+}
@@ -0,0 +1,113 @@
+package ijson
+
+import (
+	"sort"
+	"strings"
+)
+
+// SortKey describes a value of the array element to sort by.
+type SortKey struct {
+	Path string // "." separated path within the element, sorts by element itself if empty
+	Desc bool   // sort in descending order
+}
+
+type sortItem struct {
+	v interface{}   // array element
+	k []interface{} // resolved sort keys
+}
+
+// Sort sorts the array present at `"."` separated arrayPath in place. The sort is stable.
+// Sorts the data itself if arrayPath is empty.
+// Elements are compared by the provided keys in order, the element itself is compared if no key is provided.
+// A missing key is considered as null. Values of different types are ordered as
+// null < bool < number < string < object, array.
+// An error is returned if it fails to resolve the arrayPath OR the value at arrayPath is not an array.
+func Sort(data interface{}, arrayPath string, keys ...SortKey) (interface{}, error) {
+	target := data
+	if arrayPath != "" {
+		var err error
+		if target, err = GetP(data, arrayPath); err != nil {
+			return nil, err
+		}
+	}
+
+	array, valid := target.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	SortArray(array, keys...)
+
+	return data, nil
+}
+
+// SortArray sorts the array in place by provided keys. See Sort() function for details.
+func SortArray(array []interface{}, keys ...SortKey) {
+	if len(keys) == 0 {
+		keys = []SortKey{{}}
+	}
+
+	items := make([]sortItem, len(array))
+	for i := range array {
+		items[i].v = array[i]
+		items[i].k = make([]interface{}, len(keys))
+
+		for j := range keys {
+			if keys[j].Path == "" {
+				items[i].k[j] = array[i]
+				continue
+			}
+
+			// missing key is considered as null
+			items[i].k[j], _ = GetP(array[i], keys[j].Path)
+		}
+	}
+
+	sort.SliceStable(items, func(x, y int) bool {
+		for j := range keys {
+			c := compare(items[x].k[j], items[y].k[j])
+			if c == 0 {
+				continue
+			}
+
+			if keys[j].Desc {
+				return c > 0
+			}
+
+			return c < 0
+		}
+
+		return false
+	})
+
+	for i := range items {
+		array[i] = items[i].v
+	}
+}
+
+// modSort sorts a copy of the array. arg is a "," separated list of keys, a key prefixed with "-" sorts in descending order.
+func modSort(data interface{}, arg string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	var keys []SortKey
+	if arg != "" {
+		for _, k := range strings.Split(arg, ",") {
+			key := SortKey{Path: argPath(k)}
+			if strings.HasPrefix(k, "-") {
+				key = SortKey{Path: argPath(k[1:]), Desc: true}
+			}
+
+			keys = append(keys, key)
+		}
+	}
+
+	result := make([]interface{}, len(array))
+	copy(result, array)
+
+	SortArray(result, keys...)
+
+	return result, nil
+}
@@ -0,0 +1,169 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func people() []interface{} {
+	return []interface{}{
+		map[string]interface{}{"name": "tom", "age": 30},
+		map[string]interface{}{"name": "jerry", "age": 25.0},
+		map[string]interface{}{"name": "spike"},
+		map[string]interface{}{"name": "tyke", "age": 30},
+	}
+}
+
+func TestSort(t *testing.T) {
+	p := people()
+
+	type args struct {
+		data      interface{}
+		arrayPath string
+		keys      []SortKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "values/ mixed types",
+			args:    args{data: []interface{}{"b", 2, nil, true, "a", 1.5, false}},
+			want:    []interface{}{nil, false, true, 1.5, 2, "a", "b"},
+			wantErr: false,
+		},
+		{
+			name: "objects/ single key/ stable",
+			args: args{data: people(), keys: []SortKey{{Path: "age"}}},
+			want: []interface{}{p[2], p[1], p[0], p[3]},
+		},
+		{
+			name: "objects/ descending key",
+			args: args{data: people(), keys: []SortKey{{Path: "age", Desc: true}, {Path: "name"}}},
+			want: []interface{}{p[0], p[3], p[1], p[2]},
+		},
+		{
+			name: "nested array path",
+			args: args{data: map[string]interface{}{"people": people()}, arrayPath: "people", keys: []SortKey{{Path: "name", Desc: true}}},
+			want: map[string]interface{}{"people": []interface{}{p[3], p[0], p[2], p[1]}},
+		},
+		{
+			name:    "invalid array path",
+			args:    args{data: Object(), arrayPath: "friends"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "not an array",
+			args:    args{data: Object(), arrayPath: "name"},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sort(tt.args.data, tt.args.arrayPath, tt.args.keys...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sort() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGet_Sort(t *testing.T) {
+	p := people()
+
+	type args struct {
+		data interface{}
+		path []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "sort values",
+			args:    args{data: []interface{}{3, 1, 2}, path: []string{"@sort"}},
+			want:    []interface{}{1, 2, 3},
+			wantErr: false,
+		},
+		{
+			name:    "sort by keys",
+			args:    args{data: p, path: []string{"@sort:-age,name", "#~name"}},
+			want:    []interface{}{"tom", "tyke", "jerry", "spike"},
+			wantErr: false,
+		},
+		{
+			name:    "sort object",
+			args:    args{data: Object(), path: []string{"@sort"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(tt.args.data, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual(p, people()) {
+		t.Errorf("Get() modified the input, got %v", p)
+	}
+}
+
+func TestResult_Sort(t *testing.T) {
+	got := New(map[string]interface{}{"people": people()}).
+		Sort("people", SortKey{Path: "name"}).
+		GetP("people.#~name")
+
+	want := Result{val: []interface{}{"jerry", "spike", "tom", "tyke"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Sort() = %v, want %v", got, want)
+	}
+}
+
+func TestGetP_SortNestedKey(t *testing.T) {
+	data := map[string]interface{}{
+		"people": []interface{}{
+			map[string]interface{}{"id": 1, "name": map[string]interface{}{"first": "tom"}},
+			map[string]interface{}{"id": 2, "name": map[string]interface{}{"first": "jerry"}},
+			map[string]interface{}{"id": 3, "name": map[string]interface{}{"first": "jerry"}},
+		},
+	}
+
+	got, err := GetP(data, "people.@sort:-name/first,id.#~id")
+	if err != nil {
+		t.Fatalf("GetP() error = %v", err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetP() = %v, want %v", got, want)
+	}
+
+	r := New(data).GetP("people.@unique:name/first.#~id")
+	if want := (Result{val: []interface{}{1, 2}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("Result.GetP() = %v, want %v", r, want)
+	}
+
+	pred, err := Cond(`people.@sort:name/first.#0.id == 2`)
+	if err != nil {
+		t.Fatalf("Cond() error = %v", err)
+	}
+	if !pred(data) {
+		t.Errorf("Cond() predicate = false, want true")
+	}
+}
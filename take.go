@@ -0,0 +1,22 @@
+package ijson
+
+// Take deletes the element pointed by the path, same as Del(), and returns the deleted element.
+// An error is returned if it fails to resolve the path OR the element does not exist.
+func Take(data interface{}, path ...string) (newData, removed interface{}, err error) {
+	removed, err = lookup(data, path...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newData, err = Del(data, path...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newData, removed, nil
+}
+
+// TakeP is same as Take(). It just takes `"."` separated path.
+func TakeP(data interface{}, path string) (newData, removed interface{}, err error) {
+	return Take(data, split(path)...)
+}
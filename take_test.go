@@ -0,0 +1,95 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTake(t *testing.T) {
+	queue := func() map[string]interface{} {
+		return map[string]interface{}{"queue": []interface{}{"a", "b", "c"}, "name": "jobs"}
+	}
+
+	type args struct {
+		data interface{}
+		path []string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        interface{}
+		wantRemoved interface{}
+		wantErr     bool
+	}{
+		{
+			name:        "object field",
+			args:        args{data: queue(), path: []string{"name"}},
+			want:        map[string]interface{}{"queue": []interface{}{"a", "b", "c"}},
+			wantRemoved: "jobs",
+		},
+		{
+			name:        "array index",
+			args:        args{data: queue(), path: []string{"queue", "#0"}},
+			want:        map[string]interface{}{"queue": []interface{}{"c", "b"}, "name": "jobs"},
+			wantRemoved: "a",
+		},
+		{
+			name:        "array index PO",
+			args:        args{data: queue(), path: []string{"queue", "#~0"}},
+			want:        map[string]interface{}{"queue": []interface{}{"b", "c"}, "name": "jobs"},
+			wantRemoved: "a",
+		},
+		{
+			name:        "array end",
+			args:        args{data: queue(), path: []string{"queue", "#"}},
+			want:        map[string]interface{}{"queue": []interface{}{"a", "b"}, "name": "jobs"},
+			wantRemoved: "c",
+		},
+		{
+			name:        "array end/ nested field",
+			args:        args{data: []interface{}{map[string]interface{}{"x": 1}, map[string]interface{}{"x": 2, "y": 3}}, path: []string{"#", "x"}},
+			want:        []interface{}{map[string]interface{}{"x": 1}, map[string]interface{}{"y": 3}},
+			wantRemoved: 2,
+		},
+		{
+			name:    "array end/ empty array",
+			args:    args{data: []interface{}{}, path: []string{"#"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing field",
+			args:    args{data: queue(), path: []string{"id"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid path",
+			args:    args{data: queue(), path: []string{"queue", "#a"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, removed, err := Take(tt.args.data, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Take() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Take() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(removed, tt.wantRemoved) {
+				t.Errorf("Take() removed = %v, want %v", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestResult_Take(t *testing.T) {
+	r, removed := New(map[string]interface{}{"queue": []interface{}{"a", "b"}}).TakeP("queue.#")
+	r = r.SetP(removed, "processing")
+
+	want := Result{val: map[string]interface{}{"queue": []interface{}{"a"}, "processing": "b"}}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("Result.Take() = %v, want %v", r, want)
+	}
+}
@@ -0,0 +1,91 @@
+package ijson
+
+import "strings"
+
+// Unique removes the duplicate elements of array at `"."` separated arrayPath, keeping the first occurrence.
+// Removes from the data itself if arrayPath is empty. The order of remaining elements is preserved.
+// Elements are compared by the values present at `"."` separated keyPaths, the element itself is compared
+// if no keyPath is provided. A missing key is considered as null. Values are compared with deep equality,
+// numbers are compared by their value irrespective of type.
+// An error is returned if it fails to resolve the arrayPath OR the value at arrayPath is not an array.
+func Unique(data interface{}, arrayPath string, keyPaths ...string) (interface{}, error) {
+	fn := func(old interface{}, exists bool) (interface{}, error) {
+		if !exists {
+			return nil, errNotFnd
+		}
+
+		array, valid := old.([]interface{})
+		if !valid {
+			return nil, errExpArr
+		}
+
+		return unique(array, keyPaths...), nil
+	}
+
+	var path []string
+	if arrayPath != "" {
+		path = split(arrayPath)
+	}
+
+	return Update(data, fn, path...)
+}
+
+// unique removes the duplicate elements in place, keeping the first occurrence, and returns the resulting array.
+// Keys are looked up by their hash, see valueSet.
+func unique(array []interface{}, keyPaths ...string) []interface{} {
+	seen := newValueSet(len(array))
+
+	j := 0
+	for i := range array {
+		if !seen.add(uniqueKey(array[i], keyPaths)) {
+			continue
+		}
+
+		array[j] = array[i]
+		j++
+	}
+
+	// erase the removed tail (write zero value).
+	for i := j; i < len(array); i++ {
+		array[i] = nil
+	}
+
+	return array[:j]
+}
+
+// uniqueKey returns the value to compare the element by.
+func uniqueKey(elem interface{}, keyPaths []string) interface{} {
+	if len(keyPaths) == 0 {
+		return elem
+	}
+
+	k := make([]interface{}, len(keyPaths))
+	for i := range keyPaths {
+		// missing key is considered as null
+		k[i], _ = GetP(elem, keyPaths[i])
+	}
+
+	return k
+}
+
+// modUnique returns a new array without duplicates, "@unique:<key>,<key>" compares the elements by keys.
+// Key paths separate the elements with "/", see GetModifier().
+func modUnique(data interface{}, arg string) (interface{}, error) {
+	array, valid := data.([]interface{})
+	if !valid {
+		return nil, errExpArr
+	}
+
+	var keyPaths []string
+	if arg != "" {
+		keyPaths = strings.Split(arg, ",")
+		for i := range keyPaths {
+			keyPaths[i] = argPath(keyPaths[i])
+		}
+	}
+
+	result := make([]interface{}, len(array))
+	copy(result, array)
+
+	return unique(result, keyPaths...), nil
+}
@@ -0,0 +1,138 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	a := Array()
+
+	type args struct {
+		data      interface{}
+		arrayPath string
+		keyPaths  []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "values/ numbers normalized",
+			args:    args{data: []interface{}{1, "a", 1.0, int64(2), nil, "a", 2.0, nil}},
+			want:    []interface{}{1, "a", int64(2), nil},
+			wantErr: false,
+		},
+		{
+			name:    "objects/ deep equality",
+			args:    args{data: Array()},
+			want:    []interface{}{a[0], a[2]},
+			wantErr: false,
+		},
+		{
+			name:    "objects/ by key",
+			args:    args{data: people(), keyPaths: []string{"age"}},
+			want:    []interface{}{people()[0], people()[1], people()[2]},
+			wantErr: false,
+		},
+		{
+			name:    "objects/ by multiple keys",
+			args:    args{data: Array(), keyPaths: []string{"id", "name"}},
+			want:    []interface{}{a[0], a[2]},
+			wantErr: false,
+		},
+		{
+			name:    "nested array path",
+			args:    args{data: map[string]interface{}{"tags": []interface{}{"x", "y", "x"}}, arrayPath: "tags"},
+			want:    map[string]interface{}{"tags": []interface{}{"x", "y"}},
+			wantErr: false,
+		},
+		{
+			name:    "missing array path",
+			args:    args{data: map[string]interface{}{}, arrayPath: "tags"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "not an array",
+			args:    args{data: Object()},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Unique(tt.args.data, tt.args.arrayPath, tt.args.keyPaths...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unique() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unique() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGet_Unique(t *testing.T) {
+	a := Array()
+
+	type args struct {
+		data interface{}
+		path []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "unique values",
+			args:    args{data: a, path: []string{"#~name", "@unique"}},
+			want:    []interface{}{"Justine Bird", "Marianne Rutledge"},
+			wantErr: false,
+		},
+		{
+			name:    "unique by key",
+			args:    args{data: a, path: []string{"@unique:id", "#~name"}},
+			want:    []interface{}{"Justine Bird", "Marianne Rutledge"},
+			wantErr: false,
+		},
+		{
+			name:    "unique object",
+			args:    args{data: Object(), path: []string{"@unique"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(tt.args.data, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual(a, Array()) {
+		t.Errorf("Get() modified the input, got %v", a)
+	}
+}
+
+func TestResult_Unique(t *testing.T) {
+	got := New(map[string]interface{}{"friends": Array()}).
+		Unique("friends", "name").
+		GetP("friends.#~id")
+
+	want := Result{val: []interface{}{0, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Unique() = %v, want %v", got, want)
+	}
+}
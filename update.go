@@ -0,0 +1,41 @@
+package ijson
+
+import "errors"
+
+// UpdateFunc computes the new value from the old value present at the path.
+// exists is false if the path does not exist, old is nil in that case.
+// Return ErrDelete to delete the value present at the path.
+type UpdateFunc func(old interface{}, exists bool) (interface{}, error)
+
+// ErrDelete can be returned by an UpdateFunc to delete the value present at the path.
+// Deleting from an array preserves the order. Deleting a missing value is a no-op.
+var ErrDelete = errors.New("delete")
+
+// Update sets the value returned by fn at the path. fn receives the value currently present at the path.
+// It resolves the path once, and creates the structure if not present, as Set() does.
+// The data is returned as it is if fn returns ErrDelete for a missing value.
+// An error is returned if it fails to resolve the path OR fn returns an error other than ErrDelete.
+// If the path is empty, fn is called with the data itself and its result is returned.
+func Update(data interface{}, fn UpdateFunc, path ...string) (interface{}, error) {
+	if len(path) == 0 {
+		v, err := fn(data, data != nil)
+		if err == ErrDelete {
+			return nil, nil
+		}
+
+		return v, err
+	}
+
+	newData, err := set(data, nil, setOpts{update: fn}, path...)
+	if err == errNoChng {
+		// ErrDelete for a missing value, the structure created for the path is discarded
+		return data, nil
+	}
+
+	return newData, err
+}
+
+// UpdateP is same as Update(). It just takes `"."` separated path.
+func UpdateP(data interface{}, fn UpdateFunc, path string) (interface{}, error) {
+	return Update(data, fn, split(path)...)
+}
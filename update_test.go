@@ -0,0 +1,149 @@
+package ijson
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	var (
+		errFail = errors.New("fail")
+
+		incr = func(old interface{}, exists bool) (interface{}, error) {
+			if !exists {
+				return 1, nil
+			}
+
+			return old.(int) + 1, nil
+		}
+
+		remove = func(old interface{}, exists bool) (interface{}, error) {
+			return nil, ErrDelete
+		}
+
+		fail = func(old interface{}, exists bool) (interface{}, error) {
+			return nil, errFail
+		}
+	)
+
+	type args struct {
+		data interface{}
+		fn   UpdateFunc
+		path []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "object/ existing field",
+			args:    args{data: map[string]interface{}{"views": 1}, fn: incr, path: []string{"views"}},
+			want:    map[string]interface{}{"views": 2},
+			wantErr: false,
+		},
+		{
+			name:    "nil/ missing path",
+			args:    args{data: nil, fn: incr, path: []string{"stats", "views"}},
+			want:    map[string]interface{}{"stats": map[string]interface{}{"views": 1}},
+			wantErr: false,
+		},
+		{
+			name:    "array/ existing index",
+			args:    args{data: []interface{}{1, 2}, fn: incr, path: []string{"#1"}},
+			want:    []interface{}{1, 3},
+			wantErr: false,
+		},
+		{
+			name:    "array/ missing index",
+			args:    args{data: []interface{}{1}, fn: incr, path: []string{"#2"}},
+			want:    []interface{}{1, nil, 1},
+			wantErr: false,
+		},
+		{
+			name:    "array/ append",
+			args:    args{data: []interface{}{5}, fn: incr, path: []string{"#"}},
+			want:    []interface{}{5, 1},
+			wantErr: false,
+		},
+		{
+			name:    "object/ delete",
+			args:    args{data: map[string]interface{}{"views": 1, "id": 0}, fn: remove, path: []string{"views"}},
+			want:    map[string]interface{}{"id": 0},
+			wantErr: false,
+		},
+		{
+			name:    "array/ delete preserves order",
+			args:    args{data: []interface{}{1, 2, 3}, fn: remove, path: []string{"#0"}},
+			want:    []interface{}{2, 3},
+			wantErr: false,
+		},
+		{
+			name:    "array/ delete missing index",
+			args:    args{data: []interface{}{1, 2, 3}, fn: remove, path: []string{"#5"}},
+			want:    []interface{}{1, 2, 3},
+			wantErr: false,
+		},
+		{
+			name:    "nil/ delete missing path",
+			args:    args{data: nil, fn: remove, path: []string{"a", "b"}},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "object/ delete under missing parent",
+			args:    args{data: map[string]interface{}{"id": 0}, fn: remove, path: []string{"a", "#2", "b"}},
+			want:    map[string]interface{}{"id": 0},
+			wantErr: false,
+		},
+		{
+			name:    "array/ delete append",
+			args:    args{data: map[string]interface{}{"id": 0}, fn: remove, path: []string{"list", "#"}},
+			want:    map[string]interface{}{"id": 0},
+			wantErr: false,
+		},
+		{
+			name:    "no path",
+			args:    args{data: 1, fn: incr},
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name:    "callback error",
+			args:    args{data: Object(), fn: fail, path: []string{"id"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "type mismatch",
+			args:    args{data: Object(), fn: incr, path: []string{"#0"}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Update(tt.args.data, tt.args.fn, tt.args.path...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Update(t *testing.T) {
+	got := New(Nested()).Clone().UpdateP(func(old interface{}, exists bool) (interface{}, error) {
+		return old.(string) + " Jr.", nil
+	}, "#0.friends.#2.name").GetP("#0.friends.#2.name")
+
+	want := Result{val: "Marianne Rutledge Jr."}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.Update() = %v, want %v", got, want)
+	}
+}
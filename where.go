@@ -0,0 +1,107 @@
+package ijson
+
+import "strings"
+
+// Predicate reports whether the array element satisfies a condition.
+type Predicate func(elem interface{}) bool
+
+// Where returns a predicate that holds if the value at `"."` separated path within the element
+// is deeply equal to value. Numbers are compared by value irrespective of their type.
+// An empty path compares the element itself.
+func Where(path string, value interface{}) Predicate {
+	return func(elem interface{}) bool {
+		v := elem
+		if path != "" {
+			var err error
+			if v, err = GetP(elem, path); err != nil {
+				return false
+			}
+		}
+
+		return equal(v, value)
+	}
+}
+
+// Cond parses a condition of the form `<path>==<value>` OR `<path>!=<value>` to a predicate,
+// i.e. `status=="deleted"`. The value must be a valid JSON, the path is `"."` separated.
+// See Where() for details of comparison.
+func Cond(expr string) (Predicate, error) {
+	op := "=="
+	i := strings.Index(expr, op)
+	if j := strings.Index(expr, "!="); j >= 0 && (i < 0 || j < i) {
+		op, i = "!=", j
+	}
+
+	if i < 0 {
+		return nil, errInvCnd
+	}
+
+	// numbers are decoded as json.Number to compare the large integers exactly
+	value, err := unmarshal([]byte(strings.TrimSpace(expr[i+len(op):])), Number_JSON)
+	if err != nil {
+		return nil, errInvCnd
+	}
+
+	pred := Where(strings.TrimSpace(expr[:i]), value)
+	if op == "!=" {
+		return func(elem interface{}) bool { return !pred(elem) }, nil
+	}
+
+	return pred, nil
+}
+
+// DelWhere deletes all the elements of array at `"."` separated arrayPath for which pred holds.
+// Deletes from the data itself if arrayPath is empty. The order of remaining elements is preserved.
+// It returns the number of deleted elements.
+// An error is returned if it fails to resolve the arrayPath OR the value at arrayPath is not an array.
+func DelWhere(data interface{}, arrayPath string, pred Predicate) (interface{}, int, error) {
+	var n int
+
+	fn := func(old interface{}, exists bool) (interface{}, error) {
+		if !exists {
+			return nil, errNotFnd
+		}
+
+		array, valid := old.([]interface{})
+		if !valid {
+			return nil, errExpArr
+		}
+
+		array, n = filter(array, pred)
+
+		return array, nil
+	}
+
+	var path []string
+	if arrayPath != "" {
+		path = split(arrayPath)
+	}
+
+	data, err := Update(data, fn, path...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, n, nil
+}
+
+// filter removes the elements for which pred holds, in place with a single pass.
+// It returns the filtered array and the number of removed elements.
+func filter(array []interface{}, pred Predicate) ([]interface{}, int) {
+	j := 0
+	for i := range array {
+		if pred(array[i]) {
+			continue
+		}
+
+		array[j] = array[i]
+		j++
+	}
+
+	// erase the removed tail (write zero value).
+	for i := j; i < len(array); i++ {
+		array[i] = nil
+	}
+
+	return array[:j], len(array) - j
+}
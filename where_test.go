@@ -0,0 +1,173 @@
+package ijson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func records() map[string]interface{} {
+	return map[string]interface{}{
+		"records": []interface{}{
+			map[string]interface{}{"id": 1, "status": "deleted"},
+			map[string]interface{}{"id": 2, "status": "active"},
+			map[string]interface{}{"id": 3, "status": "deleted"},
+			map[string]interface{}{"id": 4},
+		},
+	}
+}
+
+func TestDelWhere(t *testing.T) {
+	type args struct {
+		data      interface{}
+		arrayPath string
+		pred      Predicate
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantN   int
+		wantErr bool
+	}{
+		{
+			name: "where",
+			args: args{data: records(), arrayPath: "records", pred: Where("status", "deleted")},
+			want: map[string]interface{}{
+				"records": []interface{}{
+					map[string]interface{}{"id": 2, "status": "active"},
+					map[string]interface{}{"id": 4},
+				},
+			},
+			wantN: 2,
+		},
+		{
+			name:  "go predicate",
+			args:  args{data: []interface{}{1, 2, 3, 4, 5}, pred: func(e interface{}) bool { return e.(int)%2 == 1 }},
+			want:  []interface{}{2, 4},
+			wantN: 3,
+		},
+		{
+			name:  "no match",
+			args:  args{data: []interface{}{1, 2}, pred: Where("", 3)},
+			want:  []interface{}{1, 2},
+			wantN: 0,
+		},
+		{
+			name:    "missing array",
+			args:    args{data: records(), arrayPath: "items", pred: Where("id", 1)},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "not an array",
+			args:    args{data: Object(), arrayPath: "name", pred: Where("id", 1)},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n, err := DelWhere(tt.args.data, tt.args.arrayPath, tt.args.pred)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DelWhere() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DelWhere() = %v, want %v", got, tt.want)
+			}
+			if n != tt.wantN {
+				t.Errorf("DelWhere() n = %v, want %v", n, tt.wantN)
+			}
+		})
+	}
+}
+
+func TestCond(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		elem    interface{}
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "string equal",
+			expr: `status=="deleted"`,
+			elem: map[string]interface{}{"status": "deleted"},
+			want: true,
+		},
+		{
+			name: "number equal",
+			expr: `meta.version == 2`,
+			elem: map[string]interface{}{"meta": map[string]interface{}{"version": 2}},
+			want: true,
+		},
+		{
+			name: "number equal/ large integer",
+			expr: `id == 9007199254740993`,
+			elem: map[string]interface{}{"id": int64(9007199254740993)},
+			want: true,
+		},
+		{
+			name: "number equal/ large integer beyond float64 precision",
+			expr: `id == 9007199254740993`,
+			elem: map[string]interface{}{"id": int64(9007199254740992)},
+			want: false,
+		},
+		{
+			name: "not equal",
+			expr: `status!="deleted"`,
+			elem: map[string]interface{}{"status": "deleted"},
+			want: false,
+		},
+		{
+			name: "not equal/ missing path",
+			expr: `status!=null`,
+			elem: map[string]interface{}{},
+			want: true,
+		},
+		{
+			name:    "no operator",
+			expr:    `status`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			expr:    `status==deleted`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pred, err := Cond(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Cond() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got := pred(tt.elem); got != tt.want {
+				t.Errorf("Cond() predicate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_DelWhere(t *testing.T) {
+	pred, _ := Cond(`status=="deleted"`)
+	r, n := New(records()).DelWhere("records", pred)
+	got := r.GetP("records.#~id")
+
+	want := Result{val: []interface{}{2, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Result.DelWhere() = %v, want %v", got, want)
+	}
+	if n != 2 {
+		t.Errorf("Result.DelWhere() removed = %v, want %v", n, 2)
+	}
+
+	if _, n := New(Object()).DelWhere("records", pred); n != 0 {
+		t.Errorf("Result.DelWhere() removed = %v, want %v on error", n, 0)
+	}
+}